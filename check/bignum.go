@@ -0,0 +1,293 @@
+package check
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigNum is the constraint satisfied by the arbitrary-precision number types
+// from the math/big package
+type BigNum[T any] interface {
+	*big.Int | *big.Rat | *big.Float
+	Cmp(y T) int
+	Sign() int
+}
+
+// bigCopy returns a copy of the number so that later changes to the
+// original do not affect a check
+func bigCopy[T BigNum[T]](x T) T {
+	switch x := any(x).(type) {
+	case *big.Int:
+		return any(new(big.Int).Set(x)).(T) //nolint:forcetypeassert
+	case *big.Rat:
+		return any(new(big.Rat).Set(x)).(T) //nolint:forcetypeassert
+	case *big.Float:
+		return any(new(big.Float).Copy(x)).(T) //nolint:forcetypeassert
+	}
+
+	return x
+}
+
+// bigArg returns the number as it should be shown in an error message. A
+// big.Rat is shown as an integer if it is one and as a fraction otherwise.
+func bigArg[T BigNum[T]](x T) any {
+	if r, ok := any(x).(*big.Rat); ok {
+		return r.RatString()
+	}
+
+	return x
+}
+
+// bigLimit returns a copy of the limit. It will panic if the limit is nil.
+func bigLimit[T BigNum[T]](checkID, name string, limit T) T {
+	if limit == nil {
+		panic(fmt.Sprintf("Impossible checks passed to %s: the %s is nil",
+			checkID, name))
+	}
+
+	return bigCopy(limit)
+}
+
+// bigNilErr returns the error to be reported when the value being checked
+// is nil
+func bigNilErr(checkID string, params map[string]any) *CheckError {
+	return newCheckError(checkID, nil, params, "the value must not be nil")
+}
+
+// bigCmpCk returns a function that will check that the value compared with
+// the limit gives a result which passes the test. The format is applied to
+// the value and the limit to give the error message.
+func bigCmpCk[T BigNum[T]](checkID string, limit T,
+	test func(cmpResult int) bool, format string,
+) ValCk[T] {
+	limit = bigLimit(checkID, "limit", limit)
+
+	return func(v T) error {
+		if v != nil && test(v.Cmp(limit)) {
+			return nil
+		}
+
+		// the limit is copied so it cannot be changed through the error
+		params := map[string]any{"limit": bigCopy(limit)}
+
+		if v == nil {
+			return bigNilErr(checkID, params)
+		}
+
+		return newCheckError(checkID, v, params,
+			format, bigArg(v), bigArg(limit))
+	}
+}
+
+// BigEQ returns a function that will check that the value is numerically
+// equal to the limit. The checks on math/big numbers compare the values
+// they point to rather than the pointers; they will return an error if the
+// value is nil and will panic when constructed if a limit is nil. The
+// limits are copied so later changes to them do not affect the check.
+func BigEQ[T BigNum[T]](limit T) ValCk[T] {
+	return bigCmpCk("BigEQ", limit,
+		func(c int) bool { return c == 0 },
+		"the value (%v) must equal %v")
+}
+
+// BigNE returns a function that will check that the value is not
+// numerically equal to the limit
+func BigNE[T BigNum[T]](limit T) ValCk[T] {
+	return bigCmpCk("BigNE", limit,
+		func(c int) bool { return c != 0 },
+		"the value (%v) must not equal %v")
+}
+
+// BigGT returns a function that will check that the value is greater than
+// the limit
+func BigGT[T BigNum[T]](limit T) ValCk[T] {
+	return bigCmpCk("BigGT", limit,
+		func(c int) bool { return c > 0 },
+		"the value (%v) must be greater than %v")
+}
+
+// BigGE returns a function that will check that the value is greater than
+// or equal to the limit
+func BigGE[T BigNum[T]](limit T) ValCk[T] {
+	return bigCmpCk("BigGE", limit,
+		func(c int) bool { return c >= 0 },
+		"the value (%v) must be greater than or equal to %v")
+}
+
+// BigLT returns a function that will check that the value is less than the
+// limit
+func BigLT[T BigNum[T]](limit T) ValCk[T] {
+	return bigCmpCk("BigLT", limit,
+		func(c int) bool { return c < 0 },
+		"the value (%v) must be less than %v")
+}
+
+// BigLE returns a function that will check that the value is less than or
+// equal to the limit
+func BigLE[T BigNum[T]](limit T) ValCk[T] {
+	return bigCmpCk("BigLE", limit,
+		func(c int) bool { return c <= 0 },
+		"the value (%v) must be less than or equal to %v")
+}
+
+// BigBetween returns a function that will check that the value lies
+// between the upper and lower limits (inclusive). It will panic if low is
+// not less than high.
+func BigBetween[T BigNum[T]](low, high T) ValCk[T] {
+	low = bigLimit("BigBetween", "lower limit", low)
+	high = bigLimit("BigBetween", "upper limit", high)
+
+	if low.Cmp(high) >= 0 {
+		panic(fmt.Sprintf("Impossible checks passed to BigBetween:"+
+			" the lower limit (%v) must be less than the upper limit (%v)",
+			bigArg(low), bigArg(high)))
+	}
+
+	return func(v T) error {
+		if v != nil && v.Cmp(low) >= 0 && v.Cmp(high) <= 0 {
+			return nil
+		}
+
+		params := map[string]any{"low": bigCopy(low), "high": bigCopy(high)}
+
+		switch {
+		case v == nil:
+			return bigNilErr("BigBetween", params)
+		case v.Cmp(low) < 0:
+			return newCheckError("BigBetween", v, params,
+				"the value (%v) must be between %v and %v - too small",
+				bigArg(v), bigArg(low), bigArg(high))
+		default:
+			return newCheckError("BigBetween", v, params,
+				"the value (%v) must be between %v and %v - too big",
+				bigArg(v), bigArg(low), bigArg(high))
+		}
+	}
+}
+
+// bigSignCk returns a function that will check that the sign of the value
+// passes the test
+func bigSignCk[T BigNum[T]](checkID string, test func(sign int) bool,
+	format string,
+) ValCk[T] {
+	return func(v T) error {
+		if v == nil {
+			return bigNilErr(checkID, nil)
+		}
+
+		if test(v.Sign()) {
+			return nil
+		}
+
+		return newCheckError(checkID, v, nil, format, bigArg(v))
+	}
+}
+
+// BigIsPositive returns a function that will check that the value is
+// greater than zero
+func BigIsPositive[T BigNum[T]]() ValCk[T] {
+	return bigSignCk[T]("BigIsPositive",
+		func(s int) bool { return s > 0 },
+		"the value (%v) must be positive")
+}
+
+// BigIsNegative returns a function that will check that the value is less
+// than zero
+func BigIsNegative[T BigNum[T]]() ValCk[T] {
+	return bigSignCk[T]("BigIsNegative",
+		func(s int) bool { return s < 0 },
+		"the value (%v) must be negative")
+}
+
+// BigIsNonNegative returns a function that will check that the value is
+// greater than or equal to zero
+func BigIsNonNegative[T BigNum[T]]() ValCk[T] {
+	return bigSignCk[T]("BigIsNonNegative",
+		func(s int) bool { return s >= 0 },
+		"the value (%v) must not be negative")
+}
+
+// BigIsZero returns a function that will check that the value is zero
+func BigIsZero[T BigNum[T]]() ValCk[T] {
+	return bigSignCk[T]("BigIsZero",
+		func(s int) bool { return s == 0 },
+		"the value (%v) must be zero")
+}
+
+// BigDivides returns a function that will check that the value is a
+// divisor of d. Zero is only a divisor of zero. It will panic if d is nil.
+func BigDivides(d *big.Int) ValCk[*big.Int] {
+	d = bigLimit("BigDivides", "dividend", d)
+
+	return func(v *big.Int) error {
+		if v != nil && (v.Sign() == 0 && d.Sign() == 0 ||
+			v.Sign() != 0 && new(big.Int).Rem(d, v).Sign() == 0) {
+			return nil
+		}
+
+		params := map[string]any{"d": bigCopy(d)}
+
+		if v == nil {
+			return bigNilErr("BigDivides", params)
+		}
+
+		return newCheckError("BigDivides", v, params,
+			"the value (%d) must be a divisor of %d", v, d)
+	}
+}
+
+// BigIsAMultiple returns a function that will check that the value is a
+// multiple of d. The only multiple of zero is zero. It will panic if d is
+// nil.
+func BigIsAMultiple(d *big.Int) ValCk[*big.Int] {
+	d = bigLimit("BigIsAMultiple", "divisor", d)
+
+	return func(v *big.Int) error {
+		if v != nil && (d.Sign() == 0 && v.Sign() == 0 ||
+			d.Sign() != 0 && new(big.Int).Rem(v, d).Sign() == 0) {
+			return nil
+		}
+
+		params := map[string]any{"d": bigCopy(d)}
+
+		if v == nil {
+			return bigNilErr("BigIsAMultiple", params)
+		}
+
+		return newCheckError("BigIsAMultiple", v, params,
+			"the value (%d) must be a multiple of %d", v, d)
+	}
+}
+
+// BigRatMaxDecimalPlaces returns a function that will check that the value
+// can be written exactly as a decimal number with no more than n digits
+// after the decimal point. For instance, a monetary amount in dollars
+// should have no more than 2 decimal places: 12.34 passes but 1/3 and
+// 12.345 fail. It will panic if n is negative.
+func BigRatMaxDecimalPlaces(n int) ValCk[*big.Rat] {
+	if n < 0 {
+		panic(fmt.Sprintf("Impossible checks passed to BigRatMaxDecimalPlaces:"+
+			" the number of decimal places (%d) must not be negative", n))
+	}
+
+	const base = 10
+
+	scale := new(big.Rat).SetInt(
+		new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(n)), nil))
+
+	return func(v *big.Rat) error {
+		params := map[string]any{"places": n}
+
+		if v == nil {
+			return bigNilErr("BigRatMaxDecimalPlaces", params)
+		}
+
+		if new(big.Rat).Mul(v, scale).IsInt() {
+			return nil
+		}
+
+		return newCheckError("BigRatMaxDecimalPlaces", v, params,
+			"the value (%v) must have no more than %d decimal places",
+			v.RatString(), n)
+	}
+}
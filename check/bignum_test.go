@@ -0,0 +1,412 @@
+package check_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestBigInt(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	hugePlus1 := new(big.Int).Add(huge, big.NewInt(1))
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[*big.Int]
+		val       *big.Int
+	}{
+		{
+			ID:        testhelper.MkID("EQ: equal values, different pointers"),
+			checkFunc: check.BigEQ(big.NewInt(5)),
+			val:       big.NewInt(5),
+		},
+		{
+			ID:        testhelper.MkID("EQ: 6 != 5"),
+			checkFunc: check.BigEQ(big.NewInt(5)),
+			val:       big.NewInt(6),
+			ExpErr:    testhelper.MkExpErr("the value (6) must equal 5"),
+		},
+		{
+			ID:        testhelper.MkID("EQ: nil"),
+			checkFunc: check.BigEQ(big.NewInt(5)),
+			ExpErr:    testhelper.MkExpErr("the value must not be nil"),
+		},
+		{
+			ID:        testhelper.MkID("NE: 5 == 5"),
+			checkFunc: check.BigNE(big.NewInt(5)),
+			val:       big.NewInt(5),
+			ExpErr:    testhelper.MkExpErr("the value (5) must not equal 5"),
+		},
+		{
+			ID:        testhelper.MkID("GT: huge+1 > huge"),
+			checkFunc: check.BigGT(huge),
+			val:       hugePlus1,
+		},
+		{
+			ID:        testhelper.MkID("GT: huge !> huge+1"),
+			checkFunc: check.BigGT(hugePlus1),
+			val:       huge,
+			ExpErr: testhelper.MkExpErr(
+				"the value (123456789012345678901234567890)" +
+					" must be greater than 123456789012345678901234567891"),
+		},
+		{
+			ID:        testhelper.MkID("GE: 5 >= 5"),
+			checkFunc: check.BigGE(big.NewInt(5)),
+			val:       big.NewInt(5),
+		},
+		{
+			ID:        testhelper.MkID("LT: 5 !< 5"),
+			checkFunc: check.BigLT(big.NewInt(5)),
+			val:       big.NewInt(5),
+			ExpErr:    testhelper.MkExpErr("must be less than 5"),
+		},
+		{
+			ID:        testhelper.MkID("LE: 6 !<= 5"),
+			checkFunc: check.BigLE(big.NewInt(5)),
+			val:       big.NewInt(6),
+			ExpErr: testhelper.MkExpErr(
+				"must be less than or equal to 5"),
+		},
+		{
+			ID:        testhelper.MkID("Between: 1 <= 1 <= 3"),
+			checkFunc: check.BigBetween(big.NewInt(1), big.NewInt(3)),
+			val:       big.NewInt(1),
+		},
+		{
+			ID:        testhelper.MkID("Between: 0 too small"),
+			checkFunc: check.BigBetween(big.NewInt(1), big.NewInt(3)),
+			val:       big.NewInt(0),
+			ExpErr: testhelper.MkExpErr(
+				"the value (0) must be between 1 and 3 - too small"),
+		},
+		{
+			ID:        testhelper.MkID("Between: 4 too big"),
+			checkFunc: check.BigBetween(big.NewInt(1), big.NewInt(3)),
+			val:       big.NewInt(4),
+			ExpErr:    testhelper.MkExpErr("too big"),
+		},
+		{
+			ID:        testhelper.MkID("IsPositive: 0"),
+			checkFunc: check.BigIsPositive[*big.Int](),
+			val:       big.NewInt(0),
+			ExpErr:    testhelper.MkExpErr("the value (0) must be positive"),
+		},
+		{
+			ID:        testhelper.MkID("IsNegative: -1"),
+			checkFunc: check.BigIsNegative[*big.Int](),
+			val:       big.NewInt(-1),
+		},
+		{
+			ID:        testhelper.MkID("IsNonNegative: -1"),
+			checkFunc: check.BigIsNonNegative[*big.Int](),
+			val:       big.NewInt(-1),
+			ExpErr: testhelper.MkExpErr(
+				"the value (-1) must not be negative"),
+		},
+		{
+			ID:        testhelper.MkID("IsZero: 0"),
+			checkFunc: check.BigIsZero[*big.Int](),
+			val:       big.NewInt(0),
+		},
+		{
+			ID:        testhelper.MkID("IsZero: nil"),
+			checkFunc: check.BigIsZero[*big.Int](),
+			ExpErr:    testhelper.MkExpErr("the value must not be nil"),
+		},
+		{
+			ID:        testhelper.MkID("Divides: 3 divides 12"),
+			checkFunc: check.BigDivides(big.NewInt(12)),
+			val:       big.NewInt(3),
+		},
+		{
+			ID:        testhelper.MkID("Divides: 5 !divides 12"),
+			checkFunc: check.BigDivides(big.NewInt(12)),
+			val:       big.NewInt(5),
+			ExpErr: testhelper.MkExpErr(
+				"the value (5) must be a divisor of 12"),
+		},
+		{
+			ID:        testhelper.MkID("Divides: 0 !divides 12"),
+			checkFunc: check.BigDivides(big.NewInt(12)),
+			val:       big.NewInt(0),
+			ExpErr: testhelper.MkExpErr(
+				"the value (0) must be a divisor of 12"),
+		},
+		{
+			ID:        testhelper.MkID("Divides: 0 divides 0"),
+			checkFunc: check.BigDivides(big.NewInt(0)),
+			val:       big.NewInt(0),
+		},
+		{
+			ID:        testhelper.MkID("Divides: 5 !divides 0"),
+			checkFunc: check.BigDivides(big.NewInt(5)),
+			val:       big.NewInt(0),
+			ExpErr: testhelper.MkExpErr(
+				"the value (0) must be a divisor of 5"),
+		},
+		{
+			ID:        testhelper.MkID("IsAMultiple: huge+1 of 2"),
+			checkFunc: check.BigIsAMultiple(big.NewInt(2)),
+			val:       hugePlus1,
+			ExpErr:    testhelper.MkExpErr("must be a multiple of 2"),
+		},
+		{
+			ID:        testhelper.MkID("IsAMultiple: 0 of 0"),
+			checkFunc: check.BigIsAMultiple(big.NewInt(0)),
+			val:       big.NewInt(0),
+		},
+		{
+			ID:        testhelper.MkID("IsAMultiple: 3 of 0"),
+			checkFunc: check.BigIsAMultiple(big.NewInt(0)),
+			val:       big.NewInt(3),
+			ExpErr: testhelper.MkExpErr(
+				"the value (3) must be a multiple of 0"),
+		},
+		{
+			ID:        testhelper.MkID("IsAMultiple: -6 of 3"),
+			checkFunc: check.BigIsAMultiple(big.NewInt(3)),
+			val:       big.NewInt(-6),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestBigIntLimitCopied(t *testing.T) {
+	limit := big.NewInt(5)
+	ck := check.BigGT(limit)
+	limit.SetInt64(10)
+
+	if err := ck(big.NewInt(6)); err != nil {
+		t.Errorf("the limit should have been copied: %v", err)
+	}
+}
+
+func TestBigErrParamsCopied(t *testing.T) {
+	// each value fails the check but would pass if the params in the error
+	// were changed to the new values and this changed the check
+	testCases := []struct {
+		testhelper.ID
+		checkFunc check.ValCk[*big.Int]
+		val       int64
+		newParams map[string]int64
+	}{
+		{
+			ID:        testhelper.MkID("BigGT"),
+			checkFunc: check.BigGT(big.NewInt(5)),
+			val:       3,
+			newParams: map[string]int64{"limit": 1},
+		},
+		{
+			ID:        testhelper.MkID("BigBetween"),
+			checkFunc: check.BigBetween(big.NewInt(5), big.NewInt(7)),
+			val:       3,
+			newParams: map[string]int64{"low": 1, "high": 10},
+		},
+		{
+			ID:        testhelper.MkID("BigDivides"),
+			checkFunc: check.BigDivides(big.NewInt(6)),
+			val:       4,
+			newParams: map[string]int64{"d": 12},
+		},
+		{
+			ID:        testhelper.MkID("BigIsAMultiple"),
+			checkFunc: check.BigIsAMultiple(big.NewInt(5)),
+			val:       3,
+			newParams: map[string]int64{"d": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		var ce *check.CheckError
+		if !errors.As(tc.checkFunc(big.NewInt(tc.val)), &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the value should fail with a *check.CheckError")
+
+			continue
+		}
+
+		for name, newVal := range tc.newParams {
+			p, ok := ce.Params[name].(*big.Int)
+			if !ok {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: the %q param should be a *big.Int", name)
+
+				continue
+			}
+
+			p.SetInt64(newVal)
+		}
+
+		if tc.checkFunc(big.NewInt(tc.val)) == nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: changing the error params changed the check")
+		}
+	}
+}
+
+func TestBigRat(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[*big.Rat]
+		val       *big.Rat
+	}{
+		{
+			ID:        testhelper.MkID("GT: 1/2 > 1/3"),
+			checkFunc: check.BigGT(big.NewRat(1, 3)),
+			val:       big.NewRat(1, 2),
+		},
+		{
+			ID:        testhelper.MkID("GT: 1/4 !> 1/3"),
+			checkFunc: check.BigGT(big.NewRat(1, 3)),
+			val:       big.NewRat(1, 4),
+			ExpErr: testhelper.MkExpErr(
+				"the value (1/4) must be greater than 1/3"),
+		},
+		{
+			ID:        testhelper.MkID("Between: 2 !<= 1"),
+			checkFunc: check.BigBetween(big.NewRat(0, 1), big.NewRat(1, 1)),
+			val:       big.NewRat(2, 1),
+			ExpErr: testhelper.MkExpErr(
+				"the value (2) must be between 0 and 1 - too big"),
+		},
+		{
+			ID:        testhelper.MkID("IsNegative: 1/2"),
+			checkFunc: check.BigIsNegative[*big.Rat](),
+			val:       big.NewRat(1, 2),
+			ExpErr:    testhelper.MkExpErr("the value (1/2) must be negative"),
+		},
+		{
+			ID:        testhelper.MkID("MaxDecimalPlaces(2): 12.34"),
+			checkFunc: check.BigRatMaxDecimalPlaces(2),
+			val:       big.NewRat(1234, 100),
+		},
+		{
+			ID:        testhelper.MkID("MaxDecimalPlaces(2): 12.3"),
+			checkFunc: check.BigRatMaxDecimalPlaces(2),
+			val:       big.NewRat(123, 10),
+		},
+		{
+			ID:        testhelper.MkID("MaxDecimalPlaces(2): 12.345"),
+			checkFunc: check.BigRatMaxDecimalPlaces(2),
+			val:       big.NewRat(12345, 1000),
+			ExpErr: testhelper.MkExpErr(
+				"the value (2469/200)" +
+					" must have no more than 2 decimal places"),
+		},
+		{
+			ID:        testhelper.MkID("MaxDecimalPlaces(5): 1/3"),
+			checkFunc: check.BigRatMaxDecimalPlaces(5),
+			val:       big.NewRat(1, 3),
+			ExpErr:    testhelper.MkExpErr("no more than 5 decimal places"),
+		},
+		{
+			ID:        testhelper.MkID("MaxDecimalPlaces(0): 7"),
+			checkFunc: check.BigRatMaxDecimalPlaces(0),
+			val:       big.NewRat(7, 1),
+		},
+		{
+			ID:        testhelper.MkID("MaxDecimalPlaces(0): nil"),
+			checkFunc: check.BigRatMaxDecimalPlaces(0),
+			ExpErr:    testhelper.MkExpErr("the value must not be nil"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[*big.Float]
+		val       *big.Float
+	}{
+		{
+			ID:        testhelper.MkID("LE: 1.5 <= 1.5"),
+			checkFunc: check.BigLE(big.NewFloat(1.5)),
+			val:       big.NewFloat(1.5),
+		},
+		{
+			ID:        testhelper.MkID("LT: 2.5 !< 1.5"),
+			checkFunc: check.BigLT(big.NewFloat(1.5)),
+			val:       big.NewFloat(2.5),
+			ExpErr: testhelper.MkExpErr(
+				"the value (2.5) must be less than 1.5"),
+		},
+		{
+			ID:        testhelper.MkID("IsPositive: 0.1"),
+			checkFunc: check.BigIsPositive[*big.Float](),
+			val:       big.NewFloat(0.1),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestBigPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("GT: nil limit"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to BigGT: the limit is nil"),
+			f: func() { check.BigGT[*big.Int](nil) },
+		},
+		{
+			ID: testhelper.MkID("Between: low == high"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to BigBetween",
+				"the lower limit (1/2)",
+				"must be less than the upper limit (1/2)"),
+			f: func() { check.BigBetween(big.NewRat(1, 2), big.NewRat(2, 4)) },
+		},
+		{
+			ID: testhelper.MkID("Between: nil upper limit"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to BigBetween",
+				"the upper limit is nil"),
+			f: func() { check.BigBetween(big.NewInt(1), nil) },
+		},
+		{
+			ID: testhelper.MkID("IsAMultiple: nil"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to BigIsAMultiple",
+				"the divisor is nil"),
+			f: func() { check.BigIsAMultiple(nil) },
+		},
+		{
+			ID: testhelper.MkID("MaxDecimalPlaces: negative"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to BigRatMaxDecimalPlaces"),
+			f: func() { check.BigRatMaxDecimalPlaces(-1) },
+		},
+		{
+			ID: testhelper.MkID("Divides: good"),
+			f:  func() { check.BigDivides(big.NewInt(0)) },
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
@@ -0,0 +1,257 @@
+package checkdesc
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// ProblemKind records the kind of Problem found with a check
+type ProblemKind int
+
+// These are the kinds of Problem that can be found
+const (
+	NeverPasses ProblemKind = iota
+	AlwaysPasses
+)
+
+// String returns a description of the ProblemKind
+func (pk ProblemKind) String() string {
+	switch pk {
+	case NeverPasses:
+		return "can never pass"
+	case AlwaysPasses:
+		return "always passes"
+	}
+
+	return fmt.Sprintf("<bad ProblemKind: %d>", int(pk))
+}
+
+// Problem records a check which can never pass or which always passes.
+// Desc is the description of the check.
+type Problem struct {
+	Desc string
+	Kind ProblemKind
+}
+
+// String returns a description of the Problem
+func (p Problem) String() string {
+	return fmt.Sprintf("the check (%s) %s", p.Desc, p.Kind)
+}
+
+// Accepts returns the set of values accepted by the check and true if this
+// is known. It is known for the ordered comparison checks (ValEQ, ValNE,
+// ValGT, ValGE, ValLT, ValLE, ValBetween and the ...Func, Time... and
+// Big... equivalents) and for any checks made by combining them with Not,
+// And or Or.
+func (c Ck[T]) Accepts() (check.IntervalSet[T], bool) {
+	if c.accepts == nil {
+		return check.IntervalSet[T]{}, false
+	}
+
+	return *c.accepts, true
+}
+
+// Problems returns a list of any checks, either this check or the checks
+// it is made from, which can never pass or which always pass. Such checks
+// are almost certainly mistakes; for instance
+//
+//	And(ValGT(10), ValLT(5))
+//
+// can never pass. Only the innermost check responsible for a problem is
+// reported so, for instance, if one of the checks combined with And can
+// never pass then the And itself is not reported as well. An And can be
+// shown never to pass even if some of its checks are not comparisons, and
+// an Or to always pass, so long as the comparisons alone are enough.
+func (c Ck[T]) Problems() []Problem {
+	return c.problems
+}
+
+// withAccepts returns the check with the set of accepted values recorded
+// and any problem with it added to the problems
+func (c Ck[T]) withAccepts(s check.IntervalSet[T]) Ck[T] {
+	c.accepts = &s
+
+	return c.withProblem(s)
+}
+
+// withProblem adds any problem with the set of accepted values to the
+// problems
+func (c Ck[T]) withProblem(s check.IntervalSet[T]) Ck[T] {
+	if pk, ok := problemKind(s); ok {
+		c.problems = append(c.problems, Problem{Desc: c.desc, Kind: pk})
+	}
+
+	return c
+}
+
+// withProblems returns the check with the problems of the checks it is
+// made from
+func (c Ck[T]) withProblems(problems ...[]Problem) Ck[T] {
+	for _, p := range problems {
+		c.problems = append(c.problems, p...)
+	}
+
+	return c
+}
+
+// allProblems returns the problems of all the checks
+func allProblems[T any](cks []Ck[T]) [][]Problem {
+	problems := make([][]Problem, 0, len(cks))
+	for _, c := range cks {
+		problems = append(problems, c.problems)
+	}
+
+	return problems
+}
+
+// combineAccepts returns the check with its set of accepted values formed
+// by combining those of the checks with the set operation and its problems
+// formed from those of the checks. The set is only recorded if it is known
+// for all the checks but a problem may be found from the checks for which
+// it is known if the set of accepted values is the one given by the
+// problem kind. The problem is not added if one of the checks already has
+// it.
+func combineAccepts[T any](c Ck[T], cks []Ck[T],
+	op func(a, b check.IntervalSet[T]) check.IntervalSet[T], kind ProblemKind,
+) Ck[T] {
+	c = c.withProblems(allProblems(cks)...)
+
+	var (
+		s         *check.IntervalSet[T]
+		unknown   bool
+		explained bool
+	)
+
+	for _, ck := range cks {
+		if ck.accepts == nil {
+			unknown = true
+
+			continue
+		}
+
+		if pk, ok := ck.problemKind(); ok && pk == kind {
+			explained = true
+		}
+
+		if s == nil {
+			s = ck.accepts
+		} else {
+			combined := op(*s, *ck.accepts)
+			s = &combined
+		}
+	}
+
+	if s == nil {
+		return c
+	}
+
+	if !unknown {
+		c.accepts = s
+	}
+
+	if pk, ok := problemKind(*s); ok && pk == kind && !explained {
+		return c.withProblem(*s)
+	}
+
+	return c
+}
+
+// problemKind returns the kind of problem that the set of accepted values
+// of the check shows and true, or false if there is no problem or the set
+// is not known
+func (c Ck[T]) problemKind() (ProblemKind, bool) {
+	if c.accepts == nil {
+		return 0, false
+	}
+
+	return problemKind(*c.accepts)
+}
+
+// problemKind returns the kind of problem that the set of accepted values
+// shows and true, or false if there is no problem
+func problemKind[T any](s check.IntervalSet[T]) (ProblemKind, bool) {
+	switch {
+	case s.IsEmpty():
+		return NeverPasses, true
+	case s.IsAll():
+		return AlwaysPasses, true
+	}
+
+	return 0, false
+}
+
+// reflectCmp returns a function comparing values of type T if T is an
+// ordered type (an integer, float or string type) and nil otherwise. It
+// allows the set of accepted values to be found for those checks which only
+// require that T is comparable.
+func reflectCmp[T any]() func(a, b T) int {
+	var zero T
+
+	t := reflect.TypeOf(zero)
+	if t == nil {
+		return nil
+	}
+
+	var cmpRV func(a, b reflect.Value) int
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		cmpRV = func(a, b reflect.Value) int {
+			return cmp.Compare(a.Int(), b.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		cmpRV = func(a, b reflect.Value) int {
+			return cmp.Compare(a.Uint(), b.Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		cmpRV = func(a, b reflect.Value) int {
+			return cmp.Compare(a.Float(), b.Float())
+		}
+	case reflect.String:
+		cmpRV = func(a, b reflect.Value) int {
+			return cmp.Compare(a.String(), b.String())
+		}
+	default:
+		return nil
+	}
+
+	return func(a, b T) int {
+		return cmpRV(reflect.ValueOf(a), reflect.ValueOf(b))
+	}
+}
+
+// reflectSet returns the set of values in the intervals and true if T is an
+// ordered type. Otherwise it returns false.
+func reflectSet[T any](ivls ...check.Interval[T]) (check.IntervalSet[T], bool) {
+	cmpFn := reflectCmp[T]()
+	if cmpFn == nil {
+		return check.IntervalSet[T]{}, false
+	}
+
+	return check.NewIntervalSetFunc(cmpFn, ivls...), true
+}
+
+// withReflectAccepts returns the check with the set of accepted values
+// made from the intervals, if T is an ordered type
+func (c Ck[T]) withReflectAccepts(ivls ...check.Interval[T]) Ck[T] {
+	if s, ok := reflectSet(ivls...); ok {
+		return c.withAccepts(s)
+	}
+
+	return c
+}
+
+// eqIntervals returns the intervals holding just the values
+func eqIntervals[T any](vals []T) []check.Interval[T] {
+	ivls := make([]check.Interval[T], 0, len(vals))
+	for _, v := range vals {
+		ivls = append(ivls, check.IntervalEQ(v))
+	}
+
+	return ivls
+}
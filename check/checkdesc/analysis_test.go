@@ -0,0 +1,183 @@
+package checkdesc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// problemStrs returns the problems as strings
+func problemStrs(problems []checkdesc.Problem) []string {
+	strs := []string{}
+	for _, p := range problems {
+		strs = append(strs, p.String())
+	}
+
+	return strs
+}
+
+func TestProblems(t *testing.T) {
+	t1 := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	testCases := []struct {
+		testhelper.ID
+		problems    []checkdesc.Problem
+		expProblems []string
+	}{
+		{
+			ID: testhelper.MkID("no problems"),
+			problems: checkdesc.And(
+				checkdesc.ValGT(1), checkdesc.ValLT(5)).Problems(),
+			expProblems: []string{},
+		},
+		{
+			ID: testhelper.MkID("And - never passes"),
+			problems: checkdesc.And(
+				checkdesc.ValGT(10), checkdesc.ValLT(5)).Problems(),
+			expProblems: []string{
+				"the check (greater than 10 and less than 5) can never pass",
+			},
+		},
+		{
+			ID: testhelper.MkID("And - no integers between"),
+			problems: checkdesc.And(
+				checkdesc.ValGT(5), checkdesc.ValLT(6)).Problems(),
+			expProblems: []string{
+				"the check (greater than 5 and less than 6) can never pass",
+			},
+		},
+		{
+			ID: testhelper.MkID("And - floats between"),
+			problems: checkdesc.And(
+				checkdesc.ValGT(5.0), checkdesc.ValLT(6.0)).Problems(),
+			expProblems: []string{},
+		},
+		{
+			ID: testhelper.MkID("And - with an unknown check"),
+			problems: checkdesc.And(
+				checkdesc.ValGT(10),
+				checkdesc.ValIsAMultiple(3),
+				checkdesc.ValLT(5)).Problems(),
+			expProblems: []string{
+				"the check (greater than 10 and a multiple of 3" +
+					" and less than 5) can never pass",
+			},
+		},
+		{
+			ID: testhelper.MkID("Or - always passes"),
+			problems: checkdesc.Or(
+				checkdesc.ValLT(5), checkdesc.ValGE(3)).Problems(),
+			expProblems: []string{
+				"the check (less than 5 or greater than or equal to 3)" +
+					" always passes",
+			},
+		},
+		{
+			ID: testhelper.MkID("Or(NE, EQ) - always passes"),
+			problems: checkdesc.Or(
+				checkdesc.ValNE("a"), checkdesc.ValEQ("a")).Problems(),
+			expProblems: []string{
+				"the check (not equal to a or equal to a) always passes",
+			},
+		},
+		{
+			ID: testhelper.MkID("Not(Or) - never passes, only Or reported"),
+			problems: checkdesc.Not(checkdesc.Or(
+				checkdesc.ValLT(5), checkdesc.ValGE(3))).Problems(),
+			expProblems: []string{
+				"the check (less than 5 or greater than or equal to 3)" +
+					" always passes",
+			},
+		},
+		{
+			ID: testhelper.MkID("nested - only the innermost reported"),
+			problems: checkdesc.Or(
+				checkdesc.And(checkdesc.ValGT(10), checkdesc.ValLT(5)),
+				checkdesc.ValEQ(7)).Problems(),
+			expProblems: []string{
+				"the check (greater than 10 and less than 5) can never pass",
+			},
+		},
+		{
+			ID: testhelper.MkID("OneOf and NoneOf - never passes"),
+			problems: checkdesc.And(
+				checkdesc.ValOneOf("a", "b"),
+				checkdesc.ValNoneOf("b", "a")).Problems(),
+			expProblems: []string{
+				"the check (one of a or b and not one of b or a)" +
+					" can never pass",
+			},
+		},
+		{
+			ID:       testhelper.MkID("uint - always passes"),
+			problems: checkdesc.ValGE[uint](0).Problems(),
+			expProblems: []string{
+				"the check (greater than or equal to 0) always passes",
+			},
+		},
+		{
+			ID: testhelper.MkID("SliceAll - problems passed on"),
+			problems: checkdesc.SliceAll[[]int](checkdesc.And(
+				checkdesc.ValGT(10), checkdesc.ValLT(5))).Problems(),
+			expProblems: []string{
+				"the check (greater than 10 and less than 5) can never pass",
+			},
+		},
+		{
+			ID: testhelper.MkID("Time - never passes"),
+			problems: checkdesc.And(
+				checkdesc.TimeGT(t2), checkdesc.TimeBetween(t1, t2)).Problems(),
+			expProblems: []string{
+				"the check (after " + t2.String() + " and between " +
+					t1.String() + " and " + t2.String() + ") can never pass",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffStringSlice(t, tc.IDStr(), "problems",
+			problemStrs(tc.problems), tc.expProblems)
+	}
+}
+
+func TestAccepts(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		ck       checkdesc.Ck[int]
+		expKnown bool
+		expSet   string
+	}{
+		{
+			ID: testhelper.MkID("Not(Between) or EQ"),
+			ck: checkdesc.Or(
+				checkdesc.Not(checkdesc.ValBetween(1, 10)),
+				checkdesc.ValEQ(5)),
+			expKnown: true,
+			expSet:   "{(-inf, 0], [5, 5], [11, +inf)}",
+		},
+		{
+			ID:       testhelper.MkID("NE"),
+			ck:       checkdesc.ValNE(5),
+			expKnown: true,
+			expSet:   "{(-inf, 4], [6, +inf)}",
+		},
+		{
+			ID: testhelper.MkID("unknown"),
+			ck: checkdesc.And(
+				checkdesc.ValGT(1), checkdesc.ValIsAMultiple(2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		s, ok := tc.ck.Accepts()
+		if testhelper.DiffBool(t, tc.IDStr(), "known", ok, tc.expKnown) ||
+			!ok {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "set", s.String(), tc.expSet)
+	}
+}
@@ -0,0 +1,106 @@
+package checkdesc
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// bigStr returns the number as it should be shown in a description
+func bigStr[T check.BigNum[T]](x T) string {
+	if r, ok := any(x).(*big.Rat); ok {
+		return r.RatString()
+	}
+
+	return fmt.Sprint(x)
+}
+
+// bigSet returns the set of big numbers in the intervals
+func bigSet[T check.BigNum[T]](ivls ...check.Interval[T],
+) check.IntervalSet[T] {
+	return check.NewIntervalSetFunc(func(a, b T) int { return a.Cmp(b) },
+		ivls...)
+}
+
+// BigEQ returns a described check.BigEQ
+func BigEQ[T check.BigNum[T]](limit T) Ck[T] {
+	return New(check.BigEQ(limit), "equal to "+bigStr(limit)).
+		withAccepts(bigSet(check.IntervalEQ(limit)))
+}
+
+// BigNE returns a described check.BigNE
+func BigNE[T check.BigNum[T]](limit T) Ck[T] {
+	return New(check.BigNE(limit), "not equal to "+bigStr(limit)).
+		withAccepts(bigSet(check.IntervalLT(limit), check.IntervalGT(limit)))
+}
+
+// BigGT returns a described check.BigGT
+func BigGT[T check.BigNum[T]](limit T) Ck[T] {
+	return New(check.BigGT(limit), "greater than "+bigStr(limit)).
+		withAccepts(bigSet(check.IntervalGT(limit)))
+}
+
+// BigGE returns a described check.BigGE
+func BigGE[T check.BigNum[T]](limit T) Ck[T] {
+	return New(check.BigGE(limit),
+		"greater than or equal to "+bigStr(limit)).
+		withAccepts(bigSet(check.IntervalGE(limit)))
+}
+
+// BigLT returns a described check.BigLT
+func BigLT[T check.BigNum[T]](limit T) Ck[T] {
+	return New(check.BigLT(limit), "less than "+bigStr(limit)).
+		withAccepts(bigSet(check.IntervalLT(limit)))
+}
+
+// BigLE returns a described check.BigLE
+func BigLE[T check.BigNum[T]](limit T) Ck[T] {
+	return New(check.BigLE(limit),
+		"less than or equal to "+bigStr(limit)).
+		withAccepts(bigSet(check.IntervalLE(limit)))
+}
+
+// BigBetween returns a described check.BigBetween. Like check.BigBetween
+// it will panic if low is not less than high.
+func BigBetween[T check.BigNum[T]](low, high T) Ck[T] {
+	return New(check.BigBetween(low, high),
+		"between "+bigStr(low)+" and "+bigStr(high)).
+		withAccepts(bigSet(check.IntervalBetween(low, high)))
+}
+
+// BigIsPositive returns a described check.BigIsPositive
+func BigIsPositive[T check.BigNum[T]]() Ck[T] {
+	return New(check.BigIsPositive[T](), "positive")
+}
+
+// BigIsNegative returns a described check.BigIsNegative
+func BigIsNegative[T check.BigNum[T]]() Ck[T] {
+	return New(check.BigIsNegative[T](), "negative")
+}
+
+// BigIsNonNegative returns a described check.BigIsNonNegative
+func BigIsNonNegative[T check.BigNum[T]]() Ck[T] {
+	return New(check.BigIsNonNegative[T](), "not negative")
+}
+
+// BigIsZero returns a described check.BigIsZero
+func BigIsZero[T check.BigNum[T]]() Ck[T] {
+	return New(check.BigIsZero[T](), "zero")
+}
+
+// BigDivides returns a described check.BigDivides
+func BigDivides(d *big.Int) Ck[*big.Int] {
+	return New(check.BigDivides(d), fmt.Sprintf("a divisor of %d", d))
+}
+
+// BigIsAMultiple returns a described check.BigIsAMultiple
+func BigIsAMultiple(d *big.Int) Ck[*big.Int] {
+	return New(check.BigIsAMultiple(d), fmt.Sprintf("a multiple of %d", d))
+}
+
+// BigRatMaxDecimalPlaces returns a described check.BigRatMaxDecimalPlaces
+func BigRatMaxDecimalPlaces(n int) Ck[*big.Rat] {
+	return New(check.BigRatMaxDecimalPlaces(n),
+		fmt.Sprintf("a number with no more than %d decimal places", n))
+}
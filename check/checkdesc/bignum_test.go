@@ -0,0 +1,67 @@
+package checkdesc_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestBigDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[*big.Rat]
+		val     *big.Rat
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("BigGT"),
+			ck:      checkdesc.BigGT(big.NewRat(1, 3)),
+			val:     big.NewRat(1, 2),
+			expDesc: "greater than 1/3",
+		},
+		{
+			ID:     testhelper.MkID("BigBetween"),
+			ExpErr: testhelper.MkExpErr("too big"),
+			ck: checkdesc.BigBetween(big.NewRat(0, 1),
+				big.NewRat(5, 1)),
+			val:     big.NewRat(6, 1),
+			expDesc: "between 0 and 5",
+		},
+		{
+			ID:      testhelper.MkID("BigIsNonNegative"),
+			ck:      checkdesc.BigIsNonNegative[*big.Rat](),
+			val:     big.NewRat(0, 1),
+			expDesc: "not negative",
+		},
+		{
+			ID:      testhelper.MkID("BigRatMaxDecimalPlaces"),
+			ExpErr:  testhelper.MkExpErr("no more than 2 decimal places"),
+			ck:      checkdesc.BigRatMaxDecimalPlaces(2),
+			val:     big.NewRat(1, 3),
+			expDesc: "a number with no more than 2 decimal places",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
+
+func TestBigProblems(t *testing.T) {
+	ck := checkdesc.And(
+		checkdesc.BigGT(big.NewInt(10)),
+		checkdesc.BigLT(big.NewInt(5)))
+
+	problems := ck.Problems()
+	if len(problems) != 1 || problems[0].Kind != checkdesc.NeverPasses {
+		t.Errorf("the check should never pass, problems: %v", problems)
+	}
+
+	testhelper.DiffString(t, "BigDivides", "description",
+		checkdesc.BigDivides(big.NewInt(12)).Desc(), "a divisor of 12")
+}
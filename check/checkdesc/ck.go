@@ -0,0 +1,124 @@
+package checkdesc
+
+import (
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// Ck is a check together with a description of the property that a value
+// must have to pass it. The description should complete the sentence "the
+// value should be ...". For instance, "greater than 5" or "a multiple of 3".
+type Ck[T any] struct {
+	ck          check.ValCk[T]
+	desc        string
+	compound    bool
+	explainSubs func(T) []*Trace
+	accepts     *check.IntervalSet[T]
+	problems    []Problem
+}
+
+// New returns a described check made from the check function and the
+// description. It will panic if the check function is nil.
+func New[T any](cf check.ValCk[T], desc string) Ck[T] {
+	if cf == nil {
+		panic("checkdesc.New: a nil check function has been given")
+	}
+
+	return Ck[T]{ck: cf, desc: desc}
+}
+
+// Check applies the check to the value. The method value (c.Check) can be
+// used wherever a check.ValCk[T] is expected.
+func (c Ck[T]) Check(v T) error {
+	return c.ck(v)
+}
+
+// ValCk returns the check function
+func (c Ck[T]) ValCk() check.ValCk[T] {
+	return c.ck
+}
+
+// Desc returns the description of the property that the check tests for
+func (c Ck[T]) Desc() string {
+	return c.desc
+}
+
+// String returns the description
+func (c Ck[T]) String() string {
+	return c.desc
+}
+
+// operand returns the description of the check in a form suitable for
+// combining with other descriptions; compound descriptions are wrapped in
+// parentheses.
+func (c Ck[T]) operand() string {
+	if c.compound {
+		return "(" + c.desc + ")"
+	}
+
+	return c.desc
+}
+
+// joinDescs returns the operand descriptions of the checks joined with the
+// conjunction
+func joinDescs[T any](conj string, cks []Ck[T]) string {
+	descs := make([]string, 0, len(cks))
+	for _, c := range cks {
+		descs = append(descs, c.operand())
+	}
+
+	return strings.Join(descs, " "+conj+" ")
+}
+
+// checks returns the check functions of the described checks
+func checks[T any](cks []Ck[T]) []check.ValCk[T] {
+	cfs := make([]check.ValCk[T], 0, len(cks))
+	for _, c := range cks {
+		cfs = append(cfs, c.ck)
+	}
+
+	return cfs
+}
+
+// Not returns a described check that passes if the check fails. It is
+// described as "not " followed by the description of the check.
+func Not[T any](c Ck[T]) Ck[T] {
+	nc := Ck[T]{
+		ck:          check.Not(c.ck, c.operand()),
+		desc:        "not " + c.operand(),
+		explainSubs: explainAll([]Ck[T]{c}),
+		problems:    c.problems,
+	}
+
+	if c.accepts != nil {
+		s := c.accepts.Complement()
+		nc.accepts = &s
+	}
+
+	return nc
+}
+
+// And returns a described check that passes if all of the checks pass. The
+// description is formed by joining the descriptions of the checks with
+// "and".
+func And[T any](cks ...Ck[T]) Ck[T] {
+	return combineAccepts(Ck[T]{
+		ck:          check.And(checks(cks)...),
+		desc:        joinDescs("and", cks),
+		compound:    len(cks) > 1,
+		explainSubs: explainAll(cks),
+	}, cks, check.IntervalSet[T].Intersect, NeverPasses)
+}
+
+// Or returns a described check that passes if any of the checks pass. The
+// description is formed by joining the descriptions of the checks with
+// "or".
+func Or[T any](cks ...Ck[T]) Ck[T] {
+	return combineAccepts(Ck[T]{
+		ck:          check.Or(checks(cks)...),
+		desc:        joinDescs("or", cks),
+		compound:    len(cks) > 1,
+		explainSubs: explainAll(cks),
+	}, cks, check.IntervalSet[T].Union, AlwaysPasses)
+}
@@ -0,0 +1,89 @@
+package checkdesc_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCompose(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[int]
+		val     int
+		expDesc string
+	}{
+		{
+			ID: testhelper.MkID("Not(And(...)) - pass"),
+			ck: checkdesc.Not(checkdesc.And(
+				checkdesc.ValGT(5), checkdesc.ValIsAMultiple(3))),
+			val:     7,
+			expDesc: "not (greater than 5 and a multiple of 3)",
+		},
+		{
+			ID: testhelper.MkID("Not(And(...)) - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"9 should not be (greater than 5 and a multiple of 3)"),
+			ck: checkdesc.Not(checkdesc.And(
+				checkdesc.ValGT(5), checkdesc.ValIsAMultiple(3))),
+			val:     9,
+			expDesc: "not (greater than 5 and a multiple of 3)",
+		},
+		{
+			ID:      testhelper.MkID("Not - simple"),
+			ExpErr:  testhelper.MkExpErr("9 should not be greater than 5"),
+			ck:      checkdesc.Not(checkdesc.ValGT(5)),
+			val:     9,
+			expDesc: "not greater than 5",
+		},
+		{
+			ID: testhelper.MkID("And(Or(...), ...)"),
+			ck: checkdesc.And(
+				checkdesc.Or(checkdesc.ValLT(0), checkdesc.ValGT(10)),
+				checkdesc.ValNE(99)),
+			val:     11,
+			expDesc: "(less than 0 or greater than 10) and not equal to 99",
+		},
+		{
+			ID:      testhelper.MkID("And - single"),
+			ck:      checkdesc.Not(checkdesc.And(checkdesc.ValGT(5))),
+			val:     1,
+			expDesc: "not greater than 5",
+		},
+		{
+			ID: testhelper.MkID("New"),
+			ck: checkdesc.New(check.ValCk[int](func(v int) error {
+				return nil
+			}), "fine"),
+			val:     1,
+			expDesc: "fine",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.DiffString(t, tc.IDStr(), "string",
+			tc.ck.String(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+		testhelper.CheckExpErr(t, tc.ck.ValCk()(tc.val), tc)
+	}
+}
+
+func TestNewPanic(t *testing.T) {
+	panicked, panicVal := testhelper.PanicSafe(func() {
+		checkdesc.New[int](nil, "nothing")
+	})
+	testhelper.CheckExpPanic(t, panicked, panicVal,
+		struct {
+			testhelper.ID
+			testhelper.ExpPanic
+		}{
+			ID: testhelper.MkID("nil check"),
+			ExpPanic: testhelper.MkExpPanic(
+				"a nil check function has been given"),
+		})
+}
@@ -0,0 +1,60 @@
+package checkdesc
+
+import (
+	"fmt"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// ValEQFunc returns a described check.ValEQFunc. Like the check package
+// functions, the ...Func functions will panic if cmpFn is nil.
+func ValEQFunc[T any](limit T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValEQFunc(limit, cmpFn),
+		fmt.Sprintf("equal to %v", limit)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn, check.IntervalEQ(limit)))
+}
+
+// ValNEFunc returns a described check.ValNEFunc
+func ValNEFunc[T any](limit T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValNEFunc(limit, cmpFn),
+		fmt.Sprintf("not equal to %v", limit)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn,
+			check.IntervalLT(limit), check.IntervalGT(limit)))
+}
+
+// ValGTFunc returns a described check.ValGTFunc
+func ValGTFunc[T any](limit T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValGTFunc(limit, cmpFn),
+		fmt.Sprintf("greater than %v", limit)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn, check.IntervalGT(limit)))
+}
+
+// ValGEFunc returns a described check.ValGEFunc
+func ValGEFunc[T any](limit T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValGEFunc(limit, cmpFn),
+		fmt.Sprintf("greater than or equal to %v", limit)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn, check.IntervalGE(limit)))
+}
+
+// ValLTFunc returns a described check.ValLTFunc
+func ValLTFunc[T any](limit T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValLTFunc(limit, cmpFn),
+		fmt.Sprintf("less than %v", limit)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn, check.IntervalLT(limit)))
+}
+
+// ValLEFunc returns a described check.ValLEFunc
+func ValLEFunc[T any](limit T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValLEFunc(limit, cmpFn),
+		fmt.Sprintf("less than or equal to %v", limit)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn, check.IntervalLE(limit)))
+}
+
+// ValBetweenFunc returns a described check.ValBetweenFunc. Like
+// check.ValBetweenFunc it will panic if low is not less than high.
+func ValBetweenFunc[T any](low, high T, cmpFn func(a, b T) int) Ck[T] {
+	return New(check.ValBetweenFunc(low, high, cmpFn),
+		fmt.Sprintf("between %v and %v", low, high)).
+		withAccepts(check.NewIntervalSetFunc(cmpFn,
+			check.IntervalBetween(low, high)))
+}
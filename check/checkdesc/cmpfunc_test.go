@@ -0,0 +1,60 @@
+package checkdesc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCmpFuncDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[string]
+		val     string
+		expDesc string
+	}{
+		{
+			ID: testhelper.MkID("ValEQFunc"),
+			ck: checkdesc.ValEQFunc("Abc",
+				func(a, b string) int {
+					return strings.Compare(strings.ToLower(a),
+						strings.ToLower(b))
+				}),
+			val:     "ABC",
+			expDesc: "equal to Abc",
+		},
+		{
+			ID:      testhelper.MkID("ValGTFunc"),
+			ExpErr:  testhelper.MkExpErr("must be greater than b"),
+			ck:      checkdesc.ValGTFunc("b", strings.Compare),
+			val:     "a",
+			expDesc: "greater than b",
+		},
+		{
+			ID:      testhelper.MkID("ValBetweenFunc"),
+			ck:      checkdesc.ValBetweenFunc("a", "c", strings.Compare),
+			val:     "b",
+			expDesc: "between a and c",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
+
+func TestCmpFuncProblems(t *testing.T) {
+	ck := checkdesc.Or(
+		checkdesc.ValLEFunc("m", strings.Compare),
+		checkdesc.ValGTFunc("m", strings.Compare))
+
+	problems := ck.Problems()
+	if len(problems) != 1 || problems[0].Kind != checkdesc.AlwaysPasses {
+		t.Errorf("the check should always pass, problems: %v", problems)
+	}
+}
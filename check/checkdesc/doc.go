@@ -0,0 +1,55 @@
+/*
+Package checkdesc provides self-describing checks. Each check is a
+check.ValCk together with a description of the property that a value must
+have to pass it, such as "greater than 5".
+
+Most of the checks on single values in the check package have a
+corresponding function in this package with the same name and parameters
+that returns a described check. These are the Val..., String..., Time...,
+FileInfo..., FilePerm... and Big... checks and the Slice... and Map...
+checks other than the aggregating, context-aware and parallel ones. Not,
+And and Or are also provided. These combining functions, together with
+the Slice... and Map... functions, build their descriptions automatically
+from the descriptions of the checks they are given. So, for instance,
+
+	checkdesc.Not(
+		checkdesc.And(
+			checkdesc.ValGT(5),
+			checkdesc.ValIsAMultiple(3)))
+
+will be described as "not (greater than 5 and a multiple of 3)". This means
+that, unlike check.Not and check.SliceAny, you do not need to supply a
+hand-written message.
+
+There is no described form of the other combining checks (such as
+AtLeastN, Xor, If, Project, Field and Warning), of the Interval... checks,
+of the context-aware and parallel variants or of the aggregators. A check
+built with one of these, like any plain check.ValCk, can be given a
+description with New and the Check method of a described check can be used
+wherever a check.ValCk is expected.
+
+For the ordered comparison checks (ValGT, ValBetween, TimeLT and so on)
+the set of values accepted by the check is known and is combined by Not,
+And and Or. The Problems method uses this to report any part of a check
+that can never pass, such as
+
+	checkdesc.And(checkdesc.ValGT(10), checkdesc.ValLT(5))
+
+or that always passes. You can call it when the check is constructed to
+catch such mistakes early.
+
+The Explain method of a described check applies it to a value and returns a
+Trace recording the outcome of the check and of every sub-check of which it
+is built. This can be shown as indented text or as JSON and is useful for
+finding out why a complicated check has passed or failed. For instance,
+
+	fmt.Print(checkdesc.SliceAll[[]int](checkdesc.ValGT(5)).
+		Explain([]int{6, 1}))
+
+will print
+
+	FAIL: a list where every entry is greater than 5
+	    [0] PASS: greater than 5
+	    [1] FAIL: greater than 5: the value (1) must be greater than 5
+*/
+package checkdesc
@@ -0,0 +1,165 @@
+package checkdesc
+
+import (
+	"io/fs"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/check.mod/v2/check/internal/keyorder"
+)
+
+// Trace records the outcome of applying a described check to a value. For
+// checks built from other checks (such as And, Or, Not, SliceAll, MapValAll
+// or the FileInfo... checks) the outcomes of the sub-checks are recorded in
+// Sub. Unlike the check itself, which may stop at the first failure, every
+// sub-check is evaluated so that the trace is complete.
+//
+// Loc gives the part of the value that the check was applied to, relative
+// to the value checked by the parent; for instance "[3]" for an entry in a
+// slice or ".Size" for the size of a file. It is empty if the check was
+// applied to the same value as its parent.
+//
+// A Trace can be rendered as indented text with the String method or as
+// JSON with the encoding/json package.
+type Trace struct {
+	Desc   string   `json:"desc"`
+	Loc    string   `json:"loc,omitempty"`
+	Passed bool     `json:"passed"`
+	Msg    string   `json:"msg,omitempty"`
+	Sub    []*Trace `json:"sub,omitempty"`
+}
+
+// Explain applies the check to the value and returns a trace of the
+// outcome of the check and of all its sub-checks.
+func (c Ck[T]) Explain(v T) *Trace {
+	t := &Trace{Desc: c.desc, Passed: true}
+
+	if err := c.ck(v); err != nil {
+		t.Passed = false
+		t.Msg = err.Error()
+	}
+
+	if c.explainSubs != nil {
+		t.Sub = c.explainSubs(v)
+	}
+
+	return t
+}
+
+// explainedBy returns the check with its sub-checks explained by the
+// function
+func (c Ck[T]) explainedBy(f func(T) []*Trace) Ck[T] {
+	c.explainSubs = f
+
+	return c
+}
+
+// at sets the location of the trace and returns it
+func (t *Trace) at(pe check.PathElem) *Trace {
+	t.Loc = pe.String()
+
+	return t
+}
+
+// String returns the trace as indented text, one line per check. Each line
+// shows the location (if any), whether the check passed or failed and the
+// description of the check. The error message is shown for failing checks
+// having no sub-checks; for the others it can be found from the sub-checks.
+func (t *Trace) String() string {
+	var s strings.Builder
+
+	t.write(&s, "")
+
+	return s.String()
+}
+
+// write writes the trace to the builder with the given indent
+func (t *Trace) write(s *strings.Builder, indent string) {
+	s.WriteString(indent)
+
+	if t.Loc != "" {
+		s.WriteString(t.Loc + " ")
+	}
+
+	if t.Passed {
+		s.WriteString("PASS: ")
+	} else {
+		s.WriteString("FAIL: ")
+	}
+
+	s.WriteString(t.Desc)
+
+	if !t.Passed && len(t.Sub) == 0 {
+		s.WriteString(": ")
+		s.WriteString(strings.ReplaceAll(t.Msg, "\n", "\n"+indent+"    "))
+	}
+
+	s.WriteString("\n")
+
+	for _, sub := range t.Sub {
+		sub.write(s, indent+"    ")
+	}
+}
+
+// explainAll returns an explainSubs function that explains each of the
+// checks applied to the value
+func explainAll[T any](cks []Ck[T]) func(T) []*Trace {
+	return func(v T) []*Trace {
+		traces := make([]*Trace, 0, len(cks))
+		for _, c := range cks {
+			traces = append(traces, c.Explain(v))
+		}
+
+		return traces
+	}
+}
+
+// explainEntries returns an explainSubs function that explains the check
+// applied to each entry in a slice
+func explainEntries[S ~[]E, E any](c Ck[E]) func(S) []*Trace {
+	return func(v S) []*Trace {
+		traces := make([]*Trace, 0, len(v))
+		for i, e := range v {
+			traces = append(traces, c.Explain(e).at(check.IndexElem(i)))
+		}
+
+		return traces
+	}
+}
+
+// explainKeys returns an explainSubs function that explains the check
+// applied to each key in a map. The keys are explained in the order given
+// by keyorder.Keys.
+func explainKeys[M ~map[K]V, K comparable, V any](c Ck[K]) func(M) []*Trace {
+	return func(v M) []*Trace {
+		traces := make([]*Trace, 0, len(v))
+		for _, k := range keyorder.Keys(v) {
+			traces = append(traces, c.Explain(k).at(check.KeyElem(k)))
+		}
+
+		return traces
+	}
+}
+
+// explainVals returns an explainSubs function that explains the check
+// applied to each value in a map. The values are explained in the order of
+// their keys as given by keyorder.Entries.
+func explainVals[M ~map[K]V, K comparable, V any](c Ck[V]) func(M) []*Trace {
+	return func(v M) []*Trace {
+		traces := make([]*Trace, 0, len(v))
+		for _, e := range keyorder.Entries(v) {
+			traces = append(traces, c.Explain(e.Val).at(check.KeyElem(e.Key)))
+		}
+
+		return traces
+	}
+}
+
+// explainPart returns an explainSubs function that explains the check
+// applied to the named part of a file
+func explainPart[F any](name string, part func(fs.FileInfo) F, c Ck[F],
+) func(fs.FileInfo) []*Trace {
+	return func(fi fs.FileInfo) []*Trace {
+		return []*Trace{c.Explain(part(fi)).at(check.FieldElem(name))}
+	}
+}
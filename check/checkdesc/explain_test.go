@@ -0,0 +1,154 @@
+package checkdesc_test
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestExplain(t *testing.T) {
+	andOrNot := checkdesc.And(
+		checkdesc.Or(checkdesc.ValLT(0), checkdesc.ValGT(10)),
+		checkdesc.Not(checkdesc.ValEQ(99)))
+
+	testCases := []struct {
+		testhelper.ID
+		trace  *checkdesc.Trace
+		expStr string
+	}{
+		{
+			ID:    testhelper.MkID("And(Or, Not) - pass"),
+			trace: andOrNot.Explain(11),
+			expStr: "PASS: (less than 0 or greater than 10)" +
+				" and not equal to 99\n" +
+				"    PASS: less than 0 or greater than 10\n" +
+				"        FAIL: less than 0:" +
+				" the value (11) must be less than 0\n" +
+				"        PASS: greater than 10\n" +
+				"    PASS: not equal to 99\n" +
+				"        FAIL: equal to 99:" +
+				" the value (11) must equal 99\n",
+		},
+		{
+			ID:    testhelper.MkID("And(Or, Not) - fail"),
+			trace: andOrNot.Explain(99),
+			expStr: "FAIL: (less than 0 or greater than 10)" +
+				" and not equal to 99\n" +
+				"    PASS: less than 0 or greater than 10\n" +
+				"        FAIL: less than 0:" +
+				" the value (99) must be less than 0\n" +
+				"        PASS: greater than 10\n" +
+				"    FAIL: not equal to 99\n" +
+				"        PASS: equal to 99\n",
+		},
+		{
+			ID: testhelper.MkID("SliceAll"),
+			trace: checkdesc.SliceAll[[]int](checkdesc.ValGT(5)).
+				Explain([]int{6, 1}),
+			expStr: "FAIL: a list where every entry is greater than 5\n" +
+				"    [0] PASS: greater than 5\n" +
+				"    [1] FAIL: greater than 5:" +
+				" the value (1) must be greater than 5\n",
+		},
+		{
+			ID: testhelper.MkID("MapValAll"),
+			trace: checkdesc.MapValAll[map[string]int](checkdesc.ValGT(5)).
+				Explain(map[string]int{"b": 1, "a": 6}),
+			expStr: "FAIL: a map where every value is greater than 5\n" +
+				`    ["a"] PASS: greater than 5` + "\n" +
+				`    ["b"] FAIL: greater than 5:` +
+				" the value (1) must be greater than 5\n",
+		},
+		{
+			ID: testhelper.MkID("MapValAll - NaN key"),
+			trace: checkdesc.MapValAll[map[float64]int](checkdesc.ValGT(5)).
+				Explain(map[float64]int{math.NaN(): 6}),
+			expStr: "PASS: a map where every value is greater than 5\n" +
+				"    [NaN] PASS: greater than 5\n",
+		},
+		{
+			ID: testhelper.MkID("SliceAllErrs - empty"),
+			trace: checkdesc.SliceAllErrs[[]int](checkdesc.ValGT(5)).
+				Explain([]int{}),
+			expStr: "PASS: a list where every entry is greater than 5\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "trace",
+			tc.trace.String(), tc.expStr)
+	}
+}
+
+func TestExplainFileInfo(t *testing.T) {
+	const fileName = "../testdata/IsAFile.PBits0600"
+
+	_ = os.Chmod(fileName, 0o600) // force the file mode
+
+	fi, err := os.Stat(fileName)
+	if err != nil {
+		t.Fatalf("pre-test setup: cannot stat %q: %s", fileName, err)
+	}
+
+	ck := checkdesc.And(
+		checkdesc.FileInfoSize(checkdesc.ValGT[int64](0)),
+		checkdesc.FileInfoPerm(checkdesc.FilePermEQ(0o600)),
+		checkdesc.FileInfoIsRegular())
+
+	testhelper.DiffString(t, "FileInfo", "trace", ck.Explain(fi).String(),
+		"FAIL: a file whose size is greater than 0"+
+			" and a file whose permissions are equal to 0600"+
+			" and a regular file\n"+
+			"    FAIL: a file whose size is greater than 0\n"+
+			"        .Size FAIL: greater than 0:"+
+			" the value (0) must be greater than 0\n"+
+			"    PASS: a file whose permissions are equal to 0600\n"+
+			"        .Mode PASS: equal to 0600\n"+
+			"    PASS: a regular file\n")
+}
+
+func TestExplainFileInfoDir(t *testing.T) {
+	const dirName = "../testdata/IsADirectory"
+
+	_ = os.Chmod(dirName, 0o755) // force the file mode
+
+	fi, err := os.Stat(dirName)
+	if err != nil {
+		t.Fatalf("pre-test setup: cannot stat %q: %s", dirName, err)
+	}
+
+	// the check is applied to the whole mode, including the directory bit,
+	// so a check against just the permission bits must fail
+	ck := checkdesc.FileInfoPerm(checkdesc.ValEQ(fi.Mode().Perm()))
+
+	trace := ck.Explain(fi)
+	testhelper.DiffBool(t, "FileInfo", "check passes",
+		ck.Check(fi) == nil, false)
+	testhelper.DiffBool(t, "FileInfo", "trace passes", trace.Passed, false)
+	testhelper.DiffString(t, "FileInfo", "trace", trace.String(),
+		"FAIL: a file whose permissions are equal to -rwxr-xr-x\n"+
+			"    .Mode FAIL: equal to -rwxr-xr-x:"+
+			" the value (drwxr-xr-x) must equal -rwxr-xr-x\n")
+}
+
+func TestExplainJSON(t *testing.T) {
+	ck := checkdesc.SliceAll[[]int](checkdesc.Not(checkdesc.ValEQ(3)))
+
+	b, err := json.Marshal(ck.Explain([]int{3}))
+	if err != nil {
+		t.Fatal("unexpected error marshalling the trace: ", err)
+	}
+
+	testhelper.DiffString(t, "SliceAll(Not)", "JSON", string(b),
+		`{"desc":"a list where every entry is not equal to 3",`+
+			`"passed":false,`+
+			`"msg":"list entry: 0 (3) does not pass the test:`+
+			` 3 should not be equal to 3",`+
+			`"sub":[{"desc":"not equal to 3","loc":"[0]","passed":false,`+
+			`"msg":"3 should not be equal to 3",`+
+			`"sub":[{"desc":"equal to 3","passed":true}]}]}`)
+}
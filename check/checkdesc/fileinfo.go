@@ -0,0 +1,56 @@
+package checkdesc
+
+import (
+	"io/fs"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/check.mod/v2/check/internal/filemode"
+)
+
+// FileInfoSize returns a described check.FileInfoSize
+func FileInfoSize(c Ck[int64]) Ck[fs.FileInfo] {
+	return New(check.FileInfoSize(c.ck),
+		"a file whose size is "+c.operand()).
+		explainedBy(explainPart("Size", fs.FileInfo.Size, c)).
+		withProblems(c.problems)
+}
+
+// FileInfoPerm returns a described check.FileInfoPerm
+func FileInfoPerm(c Ck[fs.FileMode]) Ck[fs.FileInfo] {
+	return New(check.FileInfoPerm(c.ck),
+		"a file whose permissions are "+c.operand()).
+		explainedBy(explainPart("Mode", fs.FileInfo.Mode, c)).
+		withProblems(c.problems)
+}
+
+// FileInfoName returns a described check.FileInfoName
+func FileInfoName(c Ck[string]) Ck[fs.FileInfo] {
+	return New(check.FileInfoName(c.ck),
+		"a file whose name is "+c.operand()).
+		explainedBy(explainPart("Name", fs.FileInfo.Name, c)).
+		withProblems(c.problems)
+}
+
+// FileInfoIsDir returns a described check.FileInfoIsDir
+func FileInfoIsDir() Ck[fs.FileInfo] {
+	return New(check.FileInfoIsDir, "a directory")
+}
+
+// FileInfoIsRegular returns a described check.FileInfoIsRegular
+func FileInfoIsRegular() Ck[fs.FileInfo] {
+	return New(check.FileInfoIsRegular, "a regular file")
+}
+
+// FileInfoMode returns a described check.FileInfoMode
+func FileInfoMode(m fs.FileMode) Ck[fs.FileInfo] {
+	return New(check.FileInfoMode(m), filemode.TypeName(m))
+}
+
+// FileInfoModTime returns a described check.FileInfoModTime
+func FileInfoModTime(c Ck[time.Time]) Ck[fs.FileInfo] {
+	return New(check.FileInfoModTime(c.ck),
+		"a file whose modification time is "+c.operand()).
+		explainedBy(explainPart("ModTime", fs.FileInfo.ModTime, c)).
+		withProblems(c.problems)
+}
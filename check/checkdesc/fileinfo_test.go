@@ -0,0 +1,98 @@
+package checkdesc_test
+
+import (
+	"io/fs"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFileInfoDesc(t *testing.T) {
+	const fileName = "../testdata/IsAFile.PBits0600"
+
+	_ = os.Chmod(fileName, 0o600) // force the file mode
+
+	fi, err := os.Stat(fileName)
+	if err != nil {
+		t.Fatalf("pre-test setup: cannot stat %q: %s", fileName, err)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[fs.FileInfo]
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("FileInfoSize"),
+			ck:      checkdesc.FileInfoSize(checkdesc.ValGE[int64](0)),
+			expDesc: "a file whose size is greater than or equal to 0",
+		},
+		{
+			ID: testhelper.MkID("FileInfoPerm"),
+			ck: checkdesc.FileInfoPerm(
+				checkdesc.FilePermEQ(0o600)),
+			expDesc: "a file whose permissions are equal to 0600",
+		},
+		{
+			ID: testhelper.MkID("FileInfoPerm - HasAll"),
+			ck: checkdesc.FileInfoPerm(
+				checkdesc.FilePermHasAll(0o400)),
+			expDesc: "a file whose permissions are" +
+				" permissions including all of 0400",
+		},
+		{
+			ID: testhelper.MkID("FileInfoPerm - HasNone"),
+			ck: checkdesc.FileInfoPerm(
+				checkdesc.FilePermHasNone(0o077)),
+			expDesc: "a file whose permissions are" +
+				" permissions including none of 0077",
+		},
+		{
+			ID: testhelper.MkID("FileInfoName"),
+			ck: checkdesc.FileInfoName(
+				checkdesc.StringHasPrefix[string]("IsA")),
+			expDesc: `a file whose name is a string starting with "IsA"`,
+		},
+		{
+			ID:      testhelper.MkID("FileInfoIsDir"),
+			ExpErr:  testhelper.MkExpErr("should be a directory"),
+			ck:      checkdesc.FileInfoIsDir(),
+			expDesc: "a directory",
+		},
+		{
+			ID:      testhelper.MkID("FileInfoIsRegular"),
+			ck:      checkdesc.FileInfoIsRegular(),
+			expDesc: "a regular file",
+		},
+		{
+			ID:      testhelper.MkID("FileInfoMode - regular"),
+			ck:      checkdesc.FileInfoMode(0),
+			expDesc: "a regular file",
+		},
+		{
+			ID:      testhelper.MkID("FileInfoMode - dir or symlink"),
+			ExpErr:  testhelper.MkExpErr("should have been a directory"),
+			ck:      checkdesc.FileInfoMode(fs.ModeDir | fs.ModeSymlink),
+			expDesc: "a directory or a symlink",
+		},
+		{
+			ID: testhelper.MkID("FileInfoModTime"),
+			ck: checkdesc.FileInfoModTime(
+				checkdesc.TimeLT(time.Date(1970, 1, 1, 0, 0, 0, 0,
+					time.UTC))),
+			ExpErr: testhelper.MkExpErr("the modification time of"),
+			expDesc: "a file whose modification time is before" +
+				" 1970-01-01 00:00:00 +0000 UTC",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(fi), tc)
+	}
+}
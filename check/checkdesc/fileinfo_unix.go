@@ -0,0 +1,27 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package checkdesc
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// FileInfoOwnedBySelf returns a described check.FileInfoOwnedBySelf
+func FileInfoOwnedBySelf() Ck[fs.FileInfo] {
+	return New(check.FileInfoOwnedBySelf, "a file owned by the user")
+}
+
+// FileInfoUidEQ returns a described check.FileInfoUidEQ
+func FileInfoUidEQ(uid uint32) Ck[fs.FileInfo] { //nolint:revive
+	return New(check.FileInfoUidEQ(uid),
+		fmt.Sprintf("a file owned by the user with ID %d", uid))
+}
+
+// FileInfoGidEQ returns a described check.FileInfoGidEQ
+func FileInfoGidEQ(gid uint32) Ck[fs.FileInfo] {
+	return New(check.FileInfoGidEQ(gid),
+		fmt.Sprintf("a file owned by the group with ID %d", gid))
+}
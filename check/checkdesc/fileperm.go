@@ -0,0 +1,25 @@
+package checkdesc
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// FilePermEQ returns a described check.FilePermEQ
+func FilePermEQ(perms fs.FileMode) Ck[fs.FileMode] {
+	return New(check.FilePermEQ(perms), fmt.Sprintf("equal to %04o", perms))
+}
+
+// FilePermHasAll returns a described check.FilePermHasAll
+func FilePermHasAll(perms fs.FileMode) Ck[fs.FileMode] {
+	return New(check.FilePermHasAll(perms),
+		fmt.Sprintf("permissions including all of %04o", perms))
+}
+
+// FilePermHasNone returns a described check.FilePermHasNone
+func FilePermHasNone(perms fs.FileMode) Ck[fs.FileMode] {
+	return New(check.FilePermHasNone(perms),
+		fmt.Sprintf("permissions including none of %04o", perms))
+}
@@ -0,0 +1,51 @@
+package checkdesc
+
+import (
+	"fmt"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"golang.org/x/exp/constraints"
+)
+
+// ValApproxEQ returns a described check.ValApproxEQ
+func ValApproxEQ[T constraints.Float](target T, tol check.Tolerance) Ck[T] {
+	return New(check.ValApproxEQ(target, tol),
+		fmt.Sprintf("equal to %v to within %v", target, tol))
+}
+
+// ValApproxBetween returns a described check.ValApproxBetween. Like
+// check.ValApproxBetween it will panic if low is not less than high.
+func ValApproxBetween[T constraints.Float](low, high T, tol check.Tolerance,
+) Ck[T] {
+	return New(check.ValApproxBetween(low, high, tol),
+		fmt.Sprintf("between %v and %v to within %v", low, high, tol))
+}
+
+// ValIsNaN returns a described check.ValIsNaN
+func ValIsNaN[T constraints.Float]() Ck[T] {
+	return New(check.ValIsNaN[T](), "NaN")
+}
+
+// ValNotNaN returns a described check.ValNotNaN
+func ValNotNaN[T constraints.Float]() Ck[T] {
+	return New(check.ValNotNaN[T](), "not NaN")
+}
+
+// ValIsInf returns a described check.ValIsInf
+func ValIsInf[T constraints.Float](sign int) Ck[T] {
+	desc := "infinite"
+
+	switch {
+	case sign > 0:
+		desc = "positive infinity"
+	case sign < 0:
+		desc = "negative infinity"
+	}
+
+	return New(check.ValIsInf[T](sign), desc)
+}
+
+// ValIsFinite returns a described check.ValIsFinite
+func ValIsFinite[T constraints.Float]() Ck[T] {
+	return New(check.ValIsFinite[T](), "finite")
+}
@@ -0,0 +1,68 @@
+package checkdesc_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFloatDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[float64]
+		val     float64
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("ValApproxEQ"),
+			ck:      checkdesc.ValApproxEQ(1.0, check.AbsTol(0.1)),
+			val:     1.05,
+			expDesc: "equal to 1 to within an absolute tolerance of 0.1",
+		},
+		{
+			ID:     testhelper.MkID("ValApproxBetween"),
+			ExpErr: testhelper.MkExpErr("too big"),
+			ck: checkdesc.ValApproxBetween(1.0, 2.0,
+				check.RelTol(0.01)),
+			val: 3,
+			expDesc: "between 1 and 2" +
+				" to within a relative tolerance of 0.01",
+		},
+		{
+			ID:      testhelper.MkID("ValIsNaN"),
+			ck:      checkdesc.ValIsNaN[float64](),
+			val:     math.NaN(),
+			expDesc: "NaN",
+		},
+		{
+			ID:      testhelper.MkID("ValNotNaN"),
+			ExpErr:  testhelper.MkExpErr("must not be NaN"),
+			ck:      checkdesc.ValNotNaN[float64](),
+			val:     math.NaN(),
+			expDesc: "not NaN",
+		},
+		{
+			ID:      testhelper.MkID("ValIsInf"),
+			ck:      checkdesc.ValIsInf[float64](-1),
+			val:     math.Inf(-1),
+			expDesc: "negative infinity",
+		},
+		{
+			ID:      testhelper.MkID("ValIsFinite"),
+			ExpErr:  testhelper.MkExpErr("must be finite"),
+			ck:      checkdesc.ValIsFinite[float64](),
+			val:     math.Inf(1),
+			expDesc: "finite",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
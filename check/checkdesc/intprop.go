@@ -0,0 +1,62 @@
+package checkdesc
+
+import (
+	"fmt"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"golang.org/x/exp/constraints"
+)
+
+// ValIsEven returns a described check.ValIsEven
+func ValIsEven[T constraints.Integer]() Ck[T] {
+	return New(check.ValIsEven[T](), "even")
+}
+
+// ValIsOdd returns a described check.ValIsOdd
+func ValIsOdd[T constraints.Integer]() Ck[T] {
+	return New(check.ValIsOdd[T](), "odd")
+}
+
+// ValIsPowerOf returns a described check.ValIsPowerOf. Like
+// check.ValIsPowerOf it will panic if b is less than 2.
+func ValIsPowerOf[T constraints.Integer](b T) Ck[T] {
+	return New(check.ValIsPowerOf(b), fmt.Sprintf("a power of %d", b))
+}
+
+// ValIsPrime returns a described check.ValIsPrime
+func ValIsPrime[T constraints.Integer]() Ck[T] {
+	return New(check.ValIsPrime[T](), "a prime number")
+}
+
+// ValIsPerfectSquare returns a described check.ValIsPerfectSquare
+func ValIsPerfectSquare[T constraints.Integer]() Ck[T] {
+	return New(check.ValIsPerfectSquare[T](), "a perfect square")
+}
+
+// maskStr returns the bitmask formatted as a hexadecimal number
+func maskStr[T constraints.Integer](mask T) string {
+	if mask < 0 {
+		return fmt.Sprintf("%#x", int64(mask))
+	}
+
+	return fmt.Sprintf("%#x", uint64(mask))
+}
+
+// ValHasAllBits returns a described check.ValHasAllBits
+func ValHasAllBits[T constraints.Integer](mask T) Ck[T] {
+	return New(check.ValHasAllBits(mask),
+		fmt.Sprintf("with all of the bits in %s set", maskStr(mask)))
+}
+
+// ValHasNoBits returns a described check.ValHasNoBits
+func ValHasNoBits[T constraints.Integer](mask T) Ck[T] {
+	return New(check.ValHasNoBits(mask),
+		fmt.Sprintf("with none of the bits in %s set", maskStr(mask)))
+}
+
+// ValHasExactlyOneBit returns a described check.ValHasExactlyOneBit. Like
+// check.ValHasExactlyOneBit it will panic if the mask is zero.
+func ValHasExactlyOneBit[T constraints.Integer](mask T) Ck[T] {
+	return New(check.ValHasExactlyOneBit(mask),
+		fmt.Sprintf("with exactly one of the bits in %s set", maskStr(mask)))
+}
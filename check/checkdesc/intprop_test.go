@@ -0,0 +1,76 @@
+package checkdesc_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestIntPropDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[int]
+		val     int
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("ValIsEven"),
+			ck:      checkdesc.ValIsEven[int](),
+			val:     2,
+			expDesc: "even",
+		},
+		{
+			ID:      testhelper.MkID("ValIsOdd"),
+			ExpErr:  testhelper.MkExpErr("must be odd"),
+			ck:      checkdesc.ValIsOdd[int](),
+			val:     2,
+			expDesc: "odd",
+		},
+		{
+			ID:      testhelper.MkID("ValIsPowerOf"),
+			ck:      checkdesc.ValIsPowerOf(10),
+			val:     1000,
+			expDesc: "a power of 10",
+		},
+		{
+			ID:      testhelper.MkID("ValIsPrime"),
+			ExpErr:  testhelper.MkExpErr("must be a prime number"),
+			ck:      checkdesc.ValIsPrime[int](),
+			val:     9,
+			expDesc: "a prime number",
+		},
+		{
+			ID:      testhelper.MkID("ValIsPerfectSquare"),
+			ck:      checkdesc.ValIsPerfectSquare[int](),
+			val:     9,
+			expDesc: "a perfect square",
+		},
+		{
+			ID:      testhelper.MkID("ValHasAllBits"),
+			ck:      checkdesc.ValHasAllBits(0x11),
+			val:     0x31,
+			expDesc: "with all of the bits in 0x11 set",
+		},
+		{
+			ID:      testhelper.MkID("ValHasNoBits"),
+			ExpErr:  testhelper.MkExpErr("must have none of the bits"),
+			ck:      checkdesc.ValHasNoBits(0x11),
+			val:     0x31,
+			expDesc: "with none of the bits in 0x11 set",
+		},
+		{
+			ID:      testhelper.MkID("ValHasExactlyOneBit"),
+			ck:      checkdesc.ValHasExactlyOneBit(0x81),
+			val:     0x80,
+			expDesc: "with exactly one of the bits in 0x81 set",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
@@ -0,0 +1,97 @@
+package checkdesc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/english.mod/english"
+)
+
+// timeSet returns the set of times in the intervals
+func timeSet(ivls ...check.Interval[time.Time]) check.IntervalSet[time.Time] {
+	return check.NewIntervalSetFunc(time.Time.Compare, ivls...)
+}
+
+// TimeEQ returns a described check.TimeEQ
+func TimeEQ(t time.Time) Ck[time.Time] {
+	return New(check.TimeEQ(t), fmt.Sprintf("at %s", t)).
+		withAccepts(timeSet(check.IntervalEQ(t)))
+}
+
+// TimeNE returns a described check.TimeNE
+func TimeNE(t time.Time) Ck[time.Time] {
+	return New(check.TimeNE(t), fmt.Sprintf("not at %s", t)).
+		withAccepts(timeSet(check.IntervalLT(t), check.IntervalGT(t)))
+}
+
+// TimeGT returns a described check.TimeGT
+func TimeGT(t time.Time) Ck[time.Time] {
+	return New(check.TimeGT(t), fmt.Sprintf("after %s", t)).
+		withAccepts(timeSet(check.IntervalGT(t)))
+}
+
+// TimeGE returns a described check.TimeGE
+func TimeGE(t time.Time) Ck[time.Time] {
+	return New(check.TimeGE(t), fmt.Sprintf("at or after %s", t)).
+		withAccepts(timeSet(check.IntervalGE(t)))
+}
+
+// TimeLT returns a described check.TimeLT
+func TimeLT(t time.Time) Ck[time.Time] {
+	return New(check.TimeLT(t), fmt.Sprintf("before %s", t)).
+		withAccepts(timeSet(check.IntervalLT(t)))
+}
+
+// TimeLE returns a described check.TimeLE
+func TimeLE(t time.Time) Ck[time.Time] {
+	return New(check.TimeLE(t), fmt.Sprintf("at or before %s", t)).
+		withAccepts(timeSet(check.IntervalLE(t)))
+}
+
+// TimeBetween returns a described check.TimeBetween. Like
+// check.TimeBetween it will panic if start is not before end.
+func TimeBetween(start, end time.Time) Ck[time.Time] {
+	return New(check.TimeBetween(start, end),
+		fmt.Sprintf("between %s and %s", start, end)).
+		withAccepts(timeSet(check.IntervalBetween(start, end)))
+}
+
+// TimeIsOnDOW returns a described check.TimeIsOnDOW. Like
+// check.TimeIsOnDOW it will panic if any of the days are invalid or
+// repeated.
+func TimeIsOnDOW(dow time.Weekday, otherDOW ...time.Weekday) Ck[time.Time] {
+	cf := check.TimeIsOnDOW(dow, otherDOW...)
+
+	dayNames := []string{dow.String()}
+	for _, d := range otherDOW {
+		dayNames = append(dayNames, d.String())
+	}
+
+	return New(cf, "on a "+english.Join(dayNames, ", ", " or "))
+}
+
+// TimeIsALeapYear returns a described check.TimeIsALeapYear
+func TimeIsALeapYear() Ck[time.Time] {
+	return New(check.TimeIsALeapYear, "in a leap year")
+}
+
+// TimeIsNthWeekdayOfMonth returns a described
+// check.TimeIsNthWeekdayOfMonth. Like check.TimeIsNthWeekdayOfMonth it will
+// panic if n or dow are invalid.
+func TimeIsNthWeekdayOfMonth(n int, dow time.Weekday) Ck[time.Time] {
+	cf := check.TimeIsNthWeekdayOfMonth(n, dow)
+
+	desc := fmt.Sprintf("on the %d%s %s of the month",
+		n, english.OrdinalSuffix(n), dow)
+
+	switch {
+	case n == -1:
+		desc = fmt.Sprintf("on the last %s of the month", dow)
+	case n < 0:
+		desc = fmt.Sprintf("on the %d%s %s from the end of the month",
+			-n, english.OrdinalSuffix(-n), dow)
+	}
+
+	return New(cf, desc)
+}
@@ -0,0 +1,96 @@
+package checkdesc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTimeDesc(t *testing.T) {
+	// a Tuesday, the third in the month and the second from the end
+	testTime := time.Date(2018, time.December, 18, 9, 16, 30, 0, time.UTC)
+	later := testTime.Add(time.Hour)
+	tStr := testTime.String()
+	laterStr := later.String()
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[time.Time]
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("TimeEQ"),
+			ck:      checkdesc.TimeEQ(testTime),
+			expDesc: "at " + tStr,
+		},
+		{
+			ID:      testhelper.MkID("TimeNE"),
+			ExpErr:  testhelper.MkExpErr("the time must not equal"),
+			ck:      checkdesc.TimeNE(testTime),
+			expDesc: "not at " + tStr,
+		},
+		{
+			ID:      testhelper.MkID("TimeGT"),
+			ExpErr:  testhelper.MkExpErr("must be after"),
+			ck:      checkdesc.TimeGT(testTime),
+			expDesc: "after " + tStr,
+		},
+		{
+			ID:      testhelper.MkID("TimeGE"),
+			ck:      checkdesc.TimeGE(testTime),
+			expDesc: "at or after " + tStr,
+		},
+		{
+			ID:      testhelper.MkID("TimeLT"),
+			ck:      checkdesc.TimeLT(later),
+			expDesc: "before " + laterStr,
+		},
+		{
+			ID:      testhelper.MkID("TimeLE"),
+			ck:      checkdesc.TimeLE(testTime),
+			expDesc: "at or before " + tStr,
+		},
+		{
+			ID:      testhelper.MkID("TimeBetween"),
+			ck:      checkdesc.TimeBetween(testTime, later),
+			expDesc: "between " + tStr + " and " + laterStr,
+		},
+		{
+			ID: testhelper.MkID("TimeIsOnDOW"),
+			ck: checkdesc.TimeIsOnDOW(
+				time.Monday, time.Tuesday, time.Friday),
+			expDesc: "on a Monday, Tuesday or Friday",
+		},
+		{
+			ID:      testhelper.MkID("TimeIsALeapYear"),
+			ExpErr:  testhelper.MkExpErr("is not a leap year"),
+			ck:      checkdesc.TimeIsALeapYear(),
+			expDesc: "in a leap year",
+		},
+		{
+			ID:      testhelper.MkID("TimeIsNthWeekdayOfMonth - 3rd"),
+			ck:      checkdesc.TimeIsNthWeekdayOfMonth(3, time.Tuesday),
+			expDesc: "on the 3rd Tuesday of the month",
+		},
+		{
+			ID:      testhelper.MkID("TimeIsNthWeekdayOfMonth - last"),
+			ExpErr:  testhelper.MkExpErr("is not the last Tuesday"),
+			ck:      checkdesc.TimeIsNthWeekdayOfMonth(-1, time.Tuesday),
+			expDesc: "on the last Tuesday of the month",
+		},
+		{
+			ID:      testhelper.MkID("TimeIsNthWeekdayOfMonth - 2nd last"),
+			ck:      checkdesc.TimeIsNthWeekdayOfMonth(-2, time.Tuesday),
+			expDesc: "on the 2nd Tuesday from the end of the month",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(testTime), tc)
+	}
+}
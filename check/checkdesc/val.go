@@ -0,0 +1,105 @@
+package checkdesc
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/english.mod/english"
+	"golang.org/x/exp/constraints"
+)
+
+// ValOK returns a described check that always passes
+func ValOK[T any]() Ck[T] {
+	return New(check.ValOK[T], "anything")
+}
+
+// ValEQ returns a described check.ValEQ
+func ValEQ[T comparable](limit T) Ck[T] {
+	return New(check.ValEQ(limit), fmt.Sprintf("equal to %v", limit)).
+		withReflectAccepts(check.IntervalEQ(limit))
+}
+
+// ValNE returns a described check.ValNE
+func ValNE[T comparable](limit T) Ck[T] {
+	return New(check.ValNE(limit), fmt.Sprintf("not equal to %v", limit)).
+		withReflectAccepts(check.IntervalLT(limit), check.IntervalGT(limit))
+}
+
+// ValGT returns a described check.ValGT
+func ValGT[T cmp.Ordered](limit T) Ck[T] {
+	return New(check.ValGT(limit), fmt.Sprintf("greater than %v", limit)).
+		withAccepts(check.NewIntervalSet(check.IntervalGT(limit)))
+}
+
+// ValGE returns a described check.ValGE
+func ValGE[T cmp.Ordered](limit T) Ck[T] {
+	return New(check.ValGE(limit),
+		fmt.Sprintf("greater than or equal to %v", limit)).
+		withAccepts(check.NewIntervalSet(check.IntervalGE(limit)))
+}
+
+// ValLT returns a described check.ValLT
+func ValLT[T cmp.Ordered](limit T) Ck[T] {
+	return New(check.ValLT(limit), fmt.Sprintf("less than %v", limit)).
+		withAccepts(check.NewIntervalSet(check.IntervalLT(limit)))
+}
+
+// ValLE returns a described check.ValLE
+func ValLE[T cmp.Ordered](limit T) Ck[T] {
+	return New(check.ValLE(limit),
+		fmt.Sprintf("less than or equal to %v", limit)).
+		withAccepts(check.NewIntervalSet(check.IntervalLE(limit)))
+}
+
+// ValBetween returns a described check.ValBetween. Like check.ValBetween it
+// will panic if low is not less than high.
+func ValBetween[T cmp.Ordered](low, high T) Ck[T] {
+	return New(check.ValBetween(low, high),
+		fmt.Sprintf("between %v and %v", low, high)).
+		withAccepts(check.NewIntervalSet(check.IntervalBetween(low, high)))
+}
+
+// ValDivides returns a described check.ValDivides
+func ValDivides[T constraints.Integer](d T) Ck[T] {
+	return New(check.ValDivides(d), fmt.Sprintf("a divisor of %d", d))
+}
+
+// ValIsAMultiple returns a described check.ValIsAMultiple
+func ValIsAMultiple[T constraints.Integer](d T) Ck[T] {
+	return New(check.ValIsAMultiple(d), fmt.Sprintf("a multiple of %d", d))
+}
+
+// ValOneOf returns a described check.ValOneOf. Like check.ValOneOf it will
+// panic if no values are given.
+func ValOneOf[T comparable](vals ...T) Ck[T] {
+	return New(check.ValOneOf(vals...), "one of "+joinVals(vals, "or")).
+		withReflectAccepts(eqIntervals(vals)...)
+}
+
+// ValNoneOf returns a described check.ValNoneOf
+func ValNoneOf[T comparable](vals ...T) Ck[T] {
+	c := New(check.ValNoneOf(vals...), "not one of "+joinVals(vals, "or"))
+
+	if s, ok := reflectSet(eqIntervals(vals)...); ok {
+		c = c.withAccepts(s.Complement())
+	}
+
+	return c
+}
+
+// ValInSet returns a described check.ValInSet. Like check.ValInSet it will
+// panic if the set is empty.
+func ValInSet[T any](s check.IntervalSet[T]) Ck[T] {
+	return New(check.ValInSet(s), fmt.Sprintf("in %v", s)).withAccepts(s)
+}
+
+// joinVals returns the values joined into a list with the conjunction
+func joinVals[T any](vals []T, conj string) string {
+	strs := make([]string, 0, len(vals))
+	for _, v := range vals {
+		strs = append(strs, fmt.Sprint(v))
+	}
+
+	return english.Join(strs, ", ", " "+conj+" ")
+}
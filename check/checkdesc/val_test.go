@@ -0,0 +1,173 @@
+package checkdesc_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestValDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[int]
+		val     int
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("ValOK"),
+			ck:      checkdesc.ValOK[int](),
+			val:     1,
+			expDesc: "anything",
+		},
+		{
+			ID:      testhelper.MkID("ValEQ"),
+			ck:      checkdesc.ValEQ(2),
+			val:     2,
+			expDesc: "equal to 2",
+		},
+		{
+			ID:      testhelper.MkID("ValNE"),
+			ExpErr:  testhelper.MkExpErr("must not equal 2"),
+			ck:      checkdesc.ValNE(2),
+			val:     2,
+			expDesc: "not equal to 2",
+		},
+		{
+			ID:      testhelper.MkID("ValGT"),
+			ck:      checkdesc.ValGT(2),
+			val:     3,
+			expDesc: "greater than 2",
+		},
+		{
+			ID:      testhelper.MkID("ValGE"),
+			ck:      checkdesc.ValGE(2),
+			val:     2,
+			expDesc: "greater than or equal to 2",
+		},
+		{
+			ID:      testhelper.MkID("ValLT"),
+			ExpErr:  testhelper.MkExpErr("must be less than 2"),
+			ck:      checkdesc.ValLT(2),
+			val:     2,
+			expDesc: "less than 2",
+		},
+		{
+			ID:      testhelper.MkID("ValLE"),
+			ck:      checkdesc.ValLE(2),
+			val:     2,
+			expDesc: "less than or equal to 2",
+		},
+		{
+			ID:      testhelper.MkID("ValBetween"),
+			ck:      checkdesc.ValBetween(2, 5),
+			val:     3,
+			expDesc: "between 2 and 5",
+		},
+		{
+			ID:      testhelper.MkID("ValDivides"),
+			ck:      checkdesc.ValDivides(60),
+			val:     3,
+			expDesc: "a divisor of 60",
+		},
+		{
+			ID:      testhelper.MkID("ValIsAMultiple"),
+			ExpErr:  testhelper.MkExpErr("must be a multiple of 3"),
+			ck:      checkdesc.ValIsAMultiple(3),
+			val:     7,
+			expDesc: "a multiple of 3",
+		},
+		{
+			ID:      testhelper.MkID("ValOneOf"),
+			ExpErr:  testhelper.MkExpErr("must be one of 1, 2 or 3"),
+			ck:      checkdesc.ValOneOf(1, 2, 3),
+			val:     7,
+			expDesc: "one of 1, 2 or 3",
+		},
+		{
+			ID:      testhelper.MkID("ValNoneOf"),
+			ck:      checkdesc.ValNoneOf(1, 2),
+			val:     7,
+			expDesc: "not one of 1 or 2",
+		},
+		{
+			ID: testhelper.MkID("ValInSet"),
+			ck: checkdesc.ValInSet(check.NewIntervalSet(
+				check.IntervalBetween(1, 5), check.IntervalGE(10))),
+			val:     7,
+			ExpErr:  testhelper.MkExpErr("must be in {[1, 5], [10, +inf)}"),
+			expDesc: "in {[1, 5], [10, +inf)}",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
+
+func TestStringDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[string]
+		val     string
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("StringLength"),
+			ck:      checkdesc.StringLength[string](checkdesc.ValGT(2)),
+			val:     "abc",
+			expDesc: "a string whose length is greater than 2",
+		},
+		{
+			ID: testhelper.MkID("StringLength - compound"),
+			ck: checkdesc.StringLength[string](
+				checkdesc.Or(checkdesc.ValEQ(0), checkdesc.ValGT(2))),
+			val:     "ab",
+			ExpErr:  testhelper.MkExpErr("the length of the string (2)"),
+			expDesc: "a string whose length is (equal to 0 or greater than 2)",
+		},
+		{
+			ID: testhelper.MkID("StringMatchesPattern"),
+			ck: checkdesc.StringMatchesPattern[string](
+				regexp.MustCompile("^[0-9]+$"), "numeric"),
+			val:     "123",
+			expDesc: "numeric",
+		},
+		{
+			ID:      testhelper.MkID("StringHasPrefix"),
+			ck:      checkdesc.StringHasPrefix[string]("x"),
+			val:     "xyz",
+			expDesc: `a string starting with "x"`,
+		},
+		{
+			ID:      testhelper.MkID("StringHasSuffix"),
+			ck:      checkdesc.StringHasSuffix[string]("z"),
+			val:     "xyz",
+			expDesc: `a string ending with "z"`,
+		},
+		{
+			ID:      testhelper.MkID("StringContains"),
+			ck:      checkdesc.StringContains[string]("y"),
+			val:     "xyz",
+			expDesc: `a string containing "y"`,
+		},
+		{
+			ID:      testhelper.MkID("StringFoldedEQ"),
+			ck:      checkdesc.StringFoldedEQ[string]("XYZ"),
+			val:     "xyz",
+			expDesc: `equal to "XYZ" when ignoring case`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
@@ -0,0 +1,60 @@
+package checkdesc
+
+import "github.com/nickwells/check.mod/v2/check"
+
+// MapLength returns a described check.MapLength
+func MapLength[M ~map[K]V, K comparable, V any](c Ck[int]) Ck[M] {
+	return New(check.MapLength[M](c.ck),
+		"a map whose length is "+c.operand()).
+		withProblems(c.problems)
+}
+
+// MapKeyAll returns a described check.MapKeyAll
+func MapKeyAll[M ~map[K]V, K comparable, V any](c Ck[K]) Ck[M] {
+	return New(check.MapKeyAll[M](c.ck),
+		"a map where every key is "+c.operand()).
+		explainedBy(explainKeys[M](c)).
+		withProblems(c.problems)
+}
+
+// MapKeyAllErrs returns a described check.MapKeyAllErrs
+func MapKeyAllErrs[M ~map[K]V, K comparable, V any](c Ck[K]) Ck[M] {
+	return New(check.MapKeyAllErrs[M](c.ck),
+		"a map where every key is "+c.operand()).
+		explainedBy(explainKeys[M](c)).
+		withProblems(c.problems)
+}
+
+// MapValAll returns a described check.MapValAll
+func MapValAll[M ~map[K]V, K comparable, V any](c Ck[V]) Ck[M] {
+	return New(check.MapValAll[M](c.ck),
+		"a map where every value is "+c.operand()).
+		explainedBy(explainVals[M](c)).
+		withProblems(c.problems)
+}
+
+// MapValAllErrs returns a described check.MapValAllErrs
+func MapValAllErrs[M ~map[K]V, K comparable, V any](c Ck[V]) Ck[M] {
+	return New(check.MapValAllErrs[M](c.ck),
+		"a map where every value is "+c.operand()).
+		explainedBy(explainVals[M](c)).
+		withProblems(c.problems)
+}
+
+// MapKeyAny returns a described check.MapKeyAny. The description of the
+// check is used as the message in the error.
+func MapKeyAny[M ~map[K]V, K comparable, V any](c Ck[K]) Ck[M] {
+	return New(check.MapKeyAny[M](c.ck, c.desc),
+		"a map where some key is "+c.operand()).
+		explainedBy(explainKeys[M](c)).
+		withProblems(c.problems)
+}
+
+// MapValAny returns a described check.MapValAny. The description of the
+// check is used as the message in the error.
+func MapValAny[M ~map[K]V, K comparable, V any](c Ck[V]) Ck[M] {
+	return New(check.MapValAny[M](c.ck, c.desc),
+		"a map where some value is "+c.operand()).
+		explainedBy(explainVals[M](c)).
+		withProblems(c.problems)
+}
@@ -0,0 +1,61 @@
+package checkdesc
+
+import (
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// SliceLength returns a described check.SliceLength
+func SliceLength[S ~[]E, E any](c Ck[int]) Ck[S] {
+	return New(check.SliceLength[S](c.ck),
+		"a list whose length is "+c.operand()).
+		withProblems(c.problems)
+}
+
+// SliceAll returns a described check.SliceAll
+func SliceAll[S ~[]E, E any](c Ck[E]) Ck[S] {
+	return New(check.SliceAll[S](c.ck),
+		"a list where every entry is "+c.operand()).
+		explainedBy(explainEntries[S](c)).
+		withProblems(c.problems)
+}
+
+// SliceAllErrs returns a described check.SliceAllErrs
+func SliceAllErrs[S ~[]E, E any](c Ck[E]) Ck[S] {
+	return New(check.SliceAllErrs[S](c.ck),
+		"a list where every entry is "+c.operand()).
+		explainedBy(explainEntries[S](c)).
+		withProblems(c.problems)
+}
+
+// SliceAny returns a described check.SliceAny. The description of the
+// check is used as the message in the error.
+func SliceAny[S ~[]E, E any](c Ck[E]) Ck[S] {
+	return New(check.SliceAny[S](c.ck, c.desc),
+		"a list where some entry is "+c.operand()).
+		explainedBy(explainEntries[S](c)).
+		withProblems(c.problems)
+}
+
+// SliceByPos returns a described check.SliceByPos
+func SliceByPos[S ~[]E, E any](cks ...Ck[E]) Ck[S] {
+	descs := make([]string, 0, len(cks))
+	for _, c := range cks {
+		descs = append(descs, "["+c.desc+"]")
+	}
+
+	desc := "a list"
+	if len(descs) > 0 {
+		desc = "a list whose entries, by position, are " +
+			strings.Join(descs, ", ")
+	}
+
+	return New(check.SliceByPos[S](checks(cks)...), desc).
+		withProblems(allProblems(cks)...)
+}
+
+// SliceHasNoDups returns a described check.SliceHasNoDups
+func SliceHasNoDups[S ~[]E, E comparable]() Ck[S] {
+	return New(check.SliceHasNoDups[S], "a list with no duplicates")
+}
@@ -0,0 +1,135 @@
+package checkdesc_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSliceDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[[]int]
+		val     []int
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("SliceLength"),
+			ck:      checkdesc.SliceLength[[]int](checkdesc.ValEQ(2)),
+			val:     []int{1, 2},
+			expDesc: "a list whose length is equal to 2",
+		},
+		{
+			ID:      testhelper.MkID("SliceAll"),
+			ck:      checkdesc.SliceAll[[]int](checkdesc.ValGT(0)),
+			val:     []int{1, 2},
+			expDesc: "a list where every entry is greater than 0",
+		},
+		{
+			ID:      testhelper.MkID("SliceAllErrs"),
+			ck:      checkdesc.SliceAllErrs[[]int](checkdesc.ValGT(0)),
+			ExpErr:  testhelper.MkExpErr("list entry: 0 (0)"),
+			val:     []int{0, 2},
+			expDesc: "a list where every entry is greater than 0",
+		},
+		{
+			ID: testhelper.MkID("SliceAny - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"no list entries pass the test: greater than 5"),
+			ck:      checkdesc.SliceAny[[]int](checkdesc.ValGT(5)),
+			val:     []int{1, 2},
+			expDesc: "a list where some entry is greater than 5",
+		},
+		{
+			ID: testhelper.MkID("SliceByPos"),
+			ck: checkdesc.SliceByPos[[]int](
+				checkdesc.ValEQ(1), checkdesc.ValGT(1)),
+			val: []int{1, 2, 3},
+			expDesc: "a list whose entries, by position, are" +
+				" [equal to 1], [greater than 1]",
+		},
+		{
+			ID:      testhelper.MkID("SliceByPos - no checks"),
+			ck:      checkdesc.SliceByPos[[]int](),
+			val:     []int{1, 2, 3},
+			expDesc: "a list",
+		},
+		{
+			ID:      testhelper.MkID("SliceHasNoDups"),
+			ExpErr:  testhelper.MkExpErr("duplicate list entries"),
+			ck:      checkdesc.SliceHasNoDups[[]int](),
+			val:     []int{1, 1},
+			expDesc: "a list with no duplicates",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t, tc.ck.Check(tc.val), tc)
+	}
+}
+
+func TestMapDesc(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ck      checkdesc.Ck[map[string]int]
+		expDesc string
+	}{
+		{
+			ID:      testhelper.MkID("MapLength"),
+			ck:      checkdesc.MapLength[map[string]int](checkdesc.ValEQ(2)),
+			expDesc: "a map whose length is equal to 2",
+		},
+		{
+			ID: testhelper.MkID("MapKeyAll"),
+			ck: checkdesc.MapKeyAll[map[string]int](
+				checkdesc.StringLength[string](checkdesc.ValEQ(1))),
+			expDesc: "a map where every key is" +
+				" a string whose length is equal to 1",
+		},
+		{
+			ID: testhelper.MkID("MapKeyAllErrs"),
+			ck: checkdesc.MapKeyAllErrs[map[string]int](
+				checkdesc.ValNE("a")),
+			ExpErr:  testhelper.MkExpErr("map entry[a], bad key"),
+			expDesc: "a map where every key is not equal to a",
+		},
+		{
+			ID:      testhelper.MkID("MapValAll"),
+			ck:      checkdesc.MapValAll[map[string]int](checkdesc.ValGT(0)),
+			expDesc: "a map where every value is greater than 0",
+		},
+		{
+			ID: testhelper.MkID("MapValAllErrs"),
+			ck: checkdesc.MapValAllErrs[map[string]int](
+				checkdesc.ValGT(1)),
+			ExpErr:  testhelper.MkExpErr("map entry[a], bad value"),
+			expDesc: "a map where every value is greater than 1",
+		},
+		{
+			ID: testhelper.MkID("MapKeyAny"),
+			ck: checkdesc.MapKeyAny[map[string]int](
+				checkdesc.ValEQ("z")),
+			ExpErr: testhelper.MkExpErr(
+				"no map keys pass the test: equal to z"),
+			expDesc: "a map where some key is equal to z",
+		},
+		{
+			ID: testhelper.MkID("MapValAny"),
+			ck: checkdesc.MapValAny[map[string]int](
+				checkdesc.ValEQ(2)),
+			expDesc: "a map where some value is equal to 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			tc.ck.Desc(), tc.expDesc)
+		testhelper.CheckExpErr(t,
+			tc.ck.Check(map[string]int{"a": 1, "b": 2}), tc)
+	}
+}
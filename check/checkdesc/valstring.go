@@ -0,0 +1,45 @@
+package checkdesc
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// StringLength returns a described check.StringLength
+func StringLength[T ~string](c Ck[int]) Ck[T] {
+	return New(check.StringLength[T](c.ck),
+		"a string whose length is "+c.operand()).
+		withProblems(c.problems)
+}
+
+// StringMatchesPattern returns a described check.StringMatchesPattern. The
+// regexp description is used as the description of the check.
+func StringMatchesPattern[T ~string](re *regexp.Regexp, reDesc string) Ck[T] {
+	return New(check.StringMatchesPattern[T](re, reDesc), reDesc)
+}
+
+// StringHasPrefix returns a described check.StringHasPrefix
+func StringHasPrefix[T ~string](prefix string) Ck[T] {
+	return New(check.StringHasPrefix[T](prefix),
+		fmt.Sprintf("a string starting with %q", prefix))
+}
+
+// StringHasSuffix returns a described check.StringHasSuffix
+func StringHasSuffix[T ~string](suffix string) Ck[T] {
+	return New(check.StringHasSuffix[T](suffix),
+		fmt.Sprintf("a string ending with %q", suffix))
+}
+
+// StringContains returns a described check.StringContains
+func StringContains[T ~string](substr string) Ck[T] {
+	return New(check.StringContains[T](substr),
+		fmt.Sprintf("a string containing %q", substr))
+}
+
+// StringFoldedEQ returns a described check.StringFoldedEQ
+func StringFoldedEQ[T ~string](s string) Ck[T] {
+	return New(check.StringFoldedEQ[T](s),
+		fmt.Sprintf("equal to %q when ignoring case", s))
+}
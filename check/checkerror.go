@@ -0,0 +1,128 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CheckError is the type of the error returned by the checks in this
+// package. As well as the human-readable message it records the value that
+// failed the check, a stable identifier of the check (typically the name of
+// the function that constructed it, such as "ValBetween") and the
+// parameters of the check (such as the "low" and "high" limits). This
+// allows programs to report check failures without having to parse the
+// message text.
+//
+// Where a check wraps other checks (for instance SliceAll or Or) the errors
+// from those checks are recorded in Errs and can be retrieved with the
+// standard errors.Is and errors.As functions.
+//
+// Where a check applies another check to some part of the value (for
+// instance an entry in a slice) the location of that part is recorded in
+// Loc. The full path to the failing part can be found with ErrPath.
+//
+// A check failure may be marked as a warning, see Warning for details. Any
+// warnings passed over by a check before it found an error are recorded in
+// Warnings.
+type CheckError struct { //nolint:revive
+	CheckID  string
+	Value    any
+	Params   map[string]any
+	Msg      string
+	Errs     []error
+	Loc      Path
+	Severity Severity
+	Warnings []error
+
+	isWarnings bool        // set if this only gathers together the warnings
+	text       localisable // the message in a form that can be translated
+}
+
+// Error returns the message text
+func (e *CheckError) Error() string {
+	return e.Msg
+}
+
+// Unwrap returns the errors (if any) wrapped by this error
+func (e *CheckError) Unwrap() []error {
+	return e.Errs
+}
+
+// Param returns the value of the named parameter and a bool which is false
+// if there is no such parameter.
+func (e *CheckError) Param(name string) (any, bool) {
+	v, ok := e.Params[name]
+
+	return v, ok
+}
+
+// MsgID returns the ID of the message, this is the English format string
+// used to construct it. It is used to find the translation of the message
+// (see Catalogue). It returns the empty string if the message is not
+// formed from a single format string; for instance the message of the
+// error returned by Or is formed from a sequence of messages, one for each
+// of the failing checks.
+func (e *CheckError) MsgID() string {
+	if m, ok := e.text.(msg); ok {
+		return m.id
+	}
+
+	return ""
+}
+
+// LocalMsg returns the message in the given language, see the LocalMsg
+// function for details
+func (e *CheckError) LocalMsg(lang string) string {
+	return LocalMsg(e, lang)
+}
+
+// at records the location of the part of the value that was checked and
+// returns the CheckError
+func (e *CheckError) at(pes ...PathElem) *CheckError {
+	e.Loc = append(e.Loc, pes...)
+
+	return e
+}
+
+// newCheckError returns a new CheckError. The message is formed from the
+// format and args as for fmt.Errorf and any errors wrapped with the %w verb
+// are recorded in the Errs field.
+func newCheckError(checkID string, v any, params map[string]any,
+	format string, args ...any,
+) *CheckError {
+	err := fmt.Errorf(format, args...)
+
+	ce := &CheckError{
+		CheckID: checkID,
+		Value:   v,
+		Params:  params,
+		Msg:     err.Error(),
+		text:    mkMsg(format, args...),
+	}
+
+	switch e := err.(type) { //nolint:errorlint
+	case interface{ Unwrap() error }:
+		ce.Errs = []error{e.Unwrap()}
+	case interface{ Unwrap() []error }:
+		ce.Errs = e.Unwrap()
+	}
+
+	return ce
+}
+
+// joinedErrs returns nil if there are no errors, otherwise it returns a
+// CheckError wrapping all the errors. The message is formed by joining the
+// messages of the wrapped errors with newlines, as for errors.Join.
+func joinedErrs(checkID string, v any, errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &CheckError{
+		CheckID: checkID,
+		Value:   v,
+		Params:  map[string]any{"count": len(errs)},
+		Msg:     errors.Join(errs...).Error(),
+		Errs:    errs,
+	}
+}
@@ -0,0 +1,128 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCheckError(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		err        error
+		expCheckID string
+		expValue   any
+		expParams  map[string]any
+		expNumErrs int
+	}{
+		{
+			ID:         testhelper.MkID("ValBetween"),
+			err:        check.ValBetween(1, 10)(11),
+			expCheckID: "ValBetween",
+			expValue:   11,
+			expParams:  map[string]any{"low": 1, "high": 10},
+		},
+		{
+			ID:         testhelper.MkID("StringHasPrefix"),
+			err:        check.StringHasPrefix[string]("x")("abc"),
+			expCheckID: "StringHasPrefix",
+			expValue:   "abc",
+			expParams:  map[string]any{"prefix": "x"},
+		},
+		{
+			ID: testhelper.MkID("SliceAll"),
+			err: check.SliceAll[[]int](check.ValLT(5))(
+				[]int{1, 2, 7}),
+			expCheckID: "SliceAll",
+			expValue:   []int{1, 2, 7},
+			expParams:  map[string]any{"index": 2, "entry": 7},
+			expNumErrs: 1,
+		},
+		{
+			ID:         testhelper.MkID("Or"),
+			err:        check.Or(check.ValLT(5), check.ValGT(10))(7),
+			expCheckID: "Or",
+			expValue:   7,
+			expNumErrs: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		var ce *check.CheckError
+		if !errors.As(tc.err, &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error is not a *check.CheckError: %T", tc.err)
+
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "CheckID",
+			ce.CheckID, tc.expCheckID)
+
+		if err := testhelper.DiffVals(ce.Value, tc.expValue); err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected Value: %s", err)
+		}
+
+		for k, expV := range tc.expParams {
+			v, ok := ce.Param(k)
+			if !ok {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: parameter %q is missing", k)
+
+				continue
+			}
+
+			if err := testhelper.DiffVals(v, expV); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: unexpected parameter %q: %s", k, err)
+			}
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "number of wrapped errors",
+			len(ce.Errs), tc.expNumErrs)
+		testhelper.DiffString(t, tc.IDStr(), "error message",
+			ce.Error(), tc.err.Error())
+	}
+}
+
+func TestCheckErrorWrapped(t *testing.T) {
+	cf := check.SliceAll[[]string](check.StringLength[string](check.ValLT(3)))
+	err := cf([]string{"a", "abcd"})
+
+	var ce *check.CheckError
+	if !errors.As(err, &ce) {
+		t.Fatalf("the error is not a *check.CheckError: %T", err)
+	}
+
+	testhelper.DiffString(t, "outer", "CheckID", ce.CheckID, "SliceAll")
+
+	ce = nil
+
+	for e := error(err); e != nil; {
+		var next *check.CheckError
+		if !errors.As(e, &next) {
+			break
+		}
+
+		ce = next
+
+		if len(next.Errs) != 1 {
+			break
+		}
+
+		e = next.Errs[0]
+	}
+
+	if ce == nil {
+		t.Fatal("no innermost *check.CheckError was found")
+	}
+
+	testhelper.DiffString(t, "innermost", "CheckID", ce.CheckID, "ValLT")
+
+	if err := testhelper.DiffVals(ce.Value, 4); err != nil {
+		t.Errorf("unexpected innermost Value: %s", err)
+	}
+}
@@ -0,0 +1,57 @@
+package checkexpr
+
+import (
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// ParseInt64 parses the expression and returns the corresponding check
+func ParseInt64(s string) (check.ValCk[int64], error) {
+	ck, err := parse(s, int64Funcs())
+	if err != nil {
+		return nil, err
+	}
+
+	return ck.Check, nil
+}
+
+// ParseFloat64 parses the expression and returns the corresponding check
+func ParseFloat64(s string) (check.ValCk[float64], error) {
+	ck, err := parse(s, orderedFuncs(convFloat64))
+	if err != nil {
+		return nil, err
+	}
+
+	return ck.Check, nil
+}
+
+// ParseString parses the expression and returns the corresponding check
+func ParseString(s string) (check.ValCk[string], error) {
+	ck, err := parse(s, stringFuncs())
+	if err != nil {
+		return nil, err
+	}
+
+	return ck.Check, nil
+}
+
+// ParseDuration parses the expression and returns the corresponding check
+func ParseDuration(s string) (check.ValCk[time.Duration], error) {
+	ck, err := parse(s, orderedFuncs(convDuration))
+	if err != nil {
+		return nil, err
+	}
+
+	return ck.Check, nil
+}
+
+// ParseTime parses the expression and returns the corresponding check
+func ParseTime(s string) (check.ValCk[time.Time], error) {
+	ck, err := parse(s, timeFuncs())
+	if err != nil {
+		return nil, err
+	}
+
+	return ck.Check, nil
+}
@@ -0,0 +1,316 @@
+package checkexpr_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check/checkexpr"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestParseInt64(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+		val  int64
+	}{
+		{
+			ID:   testhelper.MkID("between and not eq - pass"),
+			expr: "between(1,10) && !eq(5)",
+			val:  4,
+		},
+		{
+			ID:     testhelper.MkID("between and not eq - fail eq"),
+			ExpErr: testhelper.MkExpErr("5 should not be equal to 5"),
+			expr:   "between(1,10) && !eq(5)",
+			val:    5,
+		},
+		{
+			ID:     testhelper.MkID("between and not eq - fail between"),
+			ExpErr: testhelper.MkExpErr("must be between 1 and 10"),
+			expr:   "between(1,10) && !eq(5)",
+			val:    11,
+		},
+		{
+			ID:   testhelper.MkID("or - second passes"),
+			expr: "lt(0) || gt(100) || eq(50)",
+			val:  50,
+		},
+		{
+			ID: testhelper.MkID("or - none pass"),
+			ExpErr: testhelper.MkExpErr(
+				"either [", "must be less than 0",
+				"] or [", "must be greater than 100"),
+			expr: "lt(0) || gt(100)",
+			val:  50,
+		},
+		{
+			ID:   testhelper.MkID("precedence - && before ||"),
+			expr: "eq(1) || gt(5) && lt(10)",
+			val:  1,
+		},
+		{
+			ID:     testhelper.MkID("parentheses"),
+			ExpErr: testhelper.MkExpErr("must be less than 10"),
+			expr:   "(eq(1) || gt(5)) && lt(10)",
+			val:    11,
+		},
+		{
+			ID: testhelper.MkID("not of a compound"),
+			ExpErr: testhelper.MkExpErr(
+				"6 should not be (greater than 5 and a multiple of 3)"),
+			expr: "!(gt(5) && isAMultiple(3))",
+			val:  6,
+		},
+		{
+			ID:   testhelper.MkID("divides - hex argument"),
+			expr: "divides(0x10)",
+			val:  4,
+		},
+		{
+			ID:   testhelper.MkID("no arguments"),
+			expr: "isOdd() && isPrime() && !isPerfectSquare()",
+			val:  7,
+		},
+		{
+			ID:     testhelper.MkID("bitmask"),
+			ExpErr: testhelper.MkExpErr("must have none of the bits in 0x8"),
+			expr:   "hasAllBits(0x3) && hasNoBits(0x8)",
+			val:    11,
+		},
+		{
+			ID:   testhelper.MkID("negative argument"),
+			expr: "ge(-5) && le(+5)",
+			val:  -5,
+		},
+	}
+
+	for _, tc := range testCases {
+		ck, err := checkexpr.ParseInt64(tc.expr)
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected parse error: %s", err)
+
+			continue
+		}
+
+		testhelper.CheckExpErr(t, ck(tc.val), tc)
+	}
+}
+
+func TestParseOtherTypes(t *testing.T) {
+	testTime := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		check func() (error, error)
+	}{
+		{
+			ID: testhelper.MkID("string - prefix or numeric - pass"),
+			check: func() (error, error) {
+				ck, err := checkexpr.ParseString(
+					`hasPrefix("x") || matches("^[0-9]+$")`)
+				if err != nil {
+					return err, nil
+				}
+
+				return nil, ck("123")
+			},
+		},
+		{
+			ID: testhelper.MkID("string - prefix or numeric - fail"),
+			ExpErr: testhelper.MkExpErr(`should have "x" as a prefix`,
+				`should be: a string matching "^[0-9]+$"`),
+			check: func() (error, error) {
+				ck, err := checkexpr.ParseString(
+					`hasPrefix("x") || matches("^[0-9]+$")`)
+				if err != nil {
+					return err, nil
+				}
+
+				return nil, ck("abc")
+			},
+		},
+		{
+			ID: testhelper.MkID("string - raw string argument"),
+			check: func() (error, error) {
+				ck, err := checkexpr.ParseString(
+					"contains(`\\`) && !foldedEQ(\"ABC\")")
+				if err != nil {
+					return err, nil
+				}
+
+				return nil, ck(`a\b`)
+			},
+		},
+		{
+			ID:     testhelper.MkID("float64 - fail"),
+			ExpErr: testhelper.MkExpErr("must be less than 1.5"),
+			check: func() (error, error) {
+				ck, err := checkexpr.ParseFloat64("gt(-1e3) && lt(1.5)")
+				if err != nil {
+					return err, nil
+				}
+
+				return nil, ck(1.5)
+			},
+		},
+		{
+			ID: testhelper.MkID("duration - quoted and unquoted"),
+			check: func() (error, error) {
+				ck, err := checkexpr.ParseDuration(
+					`between(1h30m, "2h")`)
+				if err != nil {
+					return err, nil
+				}
+
+				return nil, ck(100 * time.Minute)
+			},
+		},
+		{
+			ID:     testhelper.MkID("time - fail"),
+			ExpErr: testhelper.MkExpErr("must be before"),
+			check: func() (error, error) {
+				ck, err := checkexpr.ParseTime(
+					`gt("2024-01-01T00:00:00Z") &&` +
+						` lt("2024-02-01T00:00:00Z")`)
+				if err != nil {
+					return err, nil
+				}
+
+				return nil, ck(testTime)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		parseErr, err := tc.check()
+		if parseErr != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected parse error: %s", parseErr)
+
+			continue
+		}
+
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr   string
+		expCol int
+	}{
+		{
+			ID:     testhelper.MkID("unknown check"),
+			ExpErr: testhelper.MkExpErr(`unknown check: "foo"`),
+			expr:   "eq(1) && foo(2)",
+			expCol: 10,
+		},
+		{
+			ID:     testhelper.MkID("missing close paren"),
+			ExpErr: testhelper.MkExpErr(`expected ")"`),
+			expr:   "(eq(1) || eq(2)",
+			expCol: 16,
+		},
+		{
+			ID:     testhelper.MkID("bad character"),
+			ExpErr: testhelper.MkExpErr(`unexpected character: '&'`),
+			expr:   "eq(1) & eq(2)",
+			expCol: 7,
+		},
+		{
+			ID:     testhelper.MkID("trailing tokens"),
+			ExpErr: testhelper.MkExpErr("expected an operator"),
+			expr:   "eq(1) eq(2)",
+			expCol: 7,
+		},
+		{
+			ID:     testhelper.MkID("bad integer"),
+			ExpErr: testhelper.MkExpErr(`bad integer: "1.5"`),
+			expr:   "gt(1.5)",
+			expCol: 4,
+		},
+		{
+			ID:     testhelper.MkID("wrong number of arguments"),
+			ExpErr: testhelper.MkExpErr("between takes 2 argument(s)"),
+			expr:   "!between(1)",
+			expCol: 2,
+		},
+		{
+			ID:     testhelper.MkID("argument to a check taking none"),
+			ExpErr: testhelper.MkExpErr("isEven takes 0 argument(s)"),
+			expr:   "isEven(2)",
+			expCol: 1,
+		},
+		{
+			ID:     testhelper.MkID("impossible between"),
+			ExpErr: testhelper.MkExpErr("bad check: between:", "Impossible"),
+			expr:   "between(10, 1)",
+			expCol: 1,
+		},
+		{
+			ID:     testhelper.MkID("empty"),
+			ExpErr: testhelper.MkExpErr("expected a check name or"),
+			expr:   "",
+			expCol: 1,
+		},
+		{
+			ID:     testhelper.MkID("string argument for integer"),
+			ExpErr: testhelper.MkExpErr("expected an integer"),
+			expr:   `eq("1")`,
+			expCol: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := checkexpr.ParseInt64(tc.expr)
+		if !testhelper.CheckExpErr(t, err, tc) || err == nil {
+			continue
+		}
+
+		var se *checkexpr.SyntaxError
+		if !errors.As(err, &se) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error is not a *checkexpr.SyntaxError: %T", err)
+
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "column", se.Col, tc.expCol)
+	}
+}
+
+func TestParseStringErrors(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:     testhelper.MkID("bad regexp"),
+			ExpErr: testhelper.MkExpErr("column 9: bad regexp"),
+			expr:   `matches("[")`,
+		},
+		{
+			ID:     testhelper.MkID("unterminated string"),
+			ExpErr: testhelper.MkExpErr("column 11: bad quoted string"),
+			expr:   `hasPrefix("abc)`,
+		},
+		{
+			ID:     testhelper.MkID("unquoted string"),
+			ExpErr: testhelper.MkExpErr("column 4: expected an argument"),
+			expr:   `eq(abc)`,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := checkexpr.ParseString(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Package checkexpr provides parsers which turn textual expressions into
+checks. This allows the constraints on a value to be given in, for
+instance, a configuration file rather than in Go code.
+
+An expression is made up of calls to named checks combined with the
+operators && (and), || (or) and ! (not). Parentheses can be used for
+grouping; without them ! binds most tightly and && binds more tightly than
+||. For instance,
+
+	between(1, 10) && !eq(5)
+	hasPrefix("x") || matches("^[0-9]+$")
+
+The checks available depend on the type of value being checked. These
+checks are available for all types:
+
+	eq(v) ne(v) gt(v) ge(v) lt(v) le(v) between(low, high)
+
+Integer values can also be checked with:
+
+	divides(v) isAMultiple(v) isPowerOf(v)
+	isEven() isOdd() isPrime() isPerfectSquare()
+	hasAllBits(mask) hasNoBits(mask) hasExactlyOneBit(mask)
+
+and string values with:
+
+	hasPrefix(s) hasSuffix(s) contains(s) foldedEQ(s) matches(regexp)
+
+Numeric arguments are given as Go number literals, strings as Go quoted
+strings. Duration arguments can be given either quoted or unquoted (such
+as 1h30m). Time arguments are given as quoted strings in RFC 3339 format.
+
+Any error in the expression is reported as a *SyntaxError which gives the
+column (starting from 1) at which the problem was found.
+*/
+package checkexpr
@@ -0,0 +1,240 @@
+package checkexpr
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+)
+
+// converter is the type of a function converting an argument token into a
+// value of the type being checked
+type converter[T any] func(tok token) (T, error)
+
+// argErr returns a SyntaxError reporting a bad argument
+func argErr(tok token, format string, args ...any) error {
+	return &SyntaxError{Col: tok.col, Msg: fmt.Sprintf(format, args...)}
+}
+
+// convInt64 converts a value token into an int64
+func convInt64(tok token) (int64, error) {
+	if tok.kind != tkValue {
+		return 0, argErr(tok, "expected an integer but found %s", tok.kind)
+	}
+
+	v, err := strconv.ParseInt(tok.text, 0, 64)
+	if err != nil {
+		return 0, argErr(tok, "bad integer: %q", tok.text)
+	}
+
+	return v, nil
+}
+
+// convFloat64 converts a value token into a float64
+func convFloat64(tok token) (float64, error) {
+	if tok.kind != tkValue {
+		return 0, argErr(tok, "expected a number but found %s", tok.kind)
+	}
+
+	v, err := strconv.ParseFloat(tok.text, 64)
+	if err != nil {
+		return 0, argErr(tok, "bad number: %q", tok.text)
+	}
+
+	return v, nil
+}
+
+// convString converts a string token into a string
+func convString(tok token) (string, error) {
+	if tok.kind != tkString {
+		return "", argErr(tok, "expected a quoted string but found %s",
+			tok.kind)
+	}
+
+	return tok.text, nil
+}
+
+// convDuration converts a value or string token into a time.Duration
+func convDuration(tok token) (time.Duration, error) {
+	v, err := time.ParseDuration(tok.text)
+	if err != nil {
+		return 0, argErr(tok, "bad duration: %q", tok.text)
+	}
+
+	return v, nil
+}
+
+// convTime converts a string token holding an RFC 3339 time into a
+// time.Time
+func convTime(tok token) (time.Time, error) {
+	if tok.kind != tkString {
+		return time.Time{}, argErr(tok,
+			"expected a quoted time but found %s", tok.kind)
+	}
+
+	v, err := time.Parse(time.RFC3339, tok.text)
+	if err != nil {
+		return time.Time{}, argErr(tok,
+			"bad time: %q (it must be in RFC 3339 format)", tok.text)
+	}
+
+	return v, nil
+}
+
+// wantArgs returns an error if there are not exactly n args
+func wantArgs(name token, args []token, n int) error {
+	if len(args) == n {
+		return nil
+	}
+
+	return &SyntaxError{
+		Col: name.col,
+		Msg: fmt.Sprintf("%s takes %d argument(s) but %d were given",
+			name.text, n, len(args)),
+	}
+}
+
+// construct calls the mk func, converting any panic into a SyntaxError
+// reported against the name token.
+func construct[T any](name token, mk func() checkdesc.Ck[T],
+) (ck checkdesc.Ck[T], err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &SyntaxError{
+				Col: name.col,
+				Msg: fmt.Sprintf("bad check: %s: %v", name.text, r),
+			}
+		}
+	}()
+
+	return mk(), nil
+}
+
+// noArgs returns a builder for a check taking no arguments
+func noArgs[T any](mk func() checkdesc.Ck[T]) builder[T] {
+	return func(name token, args []token) (checkdesc.Ck[T], error) {
+		if err := wantArgs(name, args, 0); err != nil {
+			return checkdesc.Ck[T]{}, err
+		}
+
+		return construct(name, mk)
+	}
+}
+
+// oneArg returns a builder for a check taking a single argument
+func oneArg[T, A any](conv converter[A], mk func(A) checkdesc.Ck[T],
+) builder[T] {
+	return func(name token, args []token) (checkdesc.Ck[T], error) {
+		if err := wantArgs(name, args, 1); err != nil {
+			return checkdesc.Ck[T]{}, err
+		}
+
+		a, err := conv(args[0])
+		if err != nil {
+			return checkdesc.Ck[T]{}, err
+		}
+
+		return construct(name, func() checkdesc.Ck[T] { return mk(a) })
+	}
+}
+
+// twoArgs returns a builder for a check taking two arguments
+func twoArgs[T, A any](conv converter[A], mk func(A, A) checkdesc.Ck[T],
+) builder[T] {
+	return func(name token, args []token) (checkdesc.Ck[T], error) {
+		if err := wantArgs(name, args, 2); err != nil {
+			return checkdesc.Ck[T]{}, err
+		}
+
+		a0, err := conv(args[0])
+		if err != nil {
+			return checkdesc.Ck[T]{}, err
+		}
+
+		a1, err := conv(args[1])
+		if err != nil {
+			return checkdesc.Ck[T]{}, err
+		}
+
+		return construct(name, func() checkdesc.Ck[T] { return mk(a0, a1) })
+	}
+}
+
+// orderedFuncs returns the checks available for all ordered types
+func orderedFuncs[T cmp.Ordered](conv converter[T]) checkFuncs[T] {
+	return checkFuncs[T]{
+		"eq":      oneArg(conv, checkdesc.ValEQ[T]),
+		"ne":      oneArg(conv, checkdesc.ValNE[T]),
+		"gt":      oneArg(conv, checkdesc.ValGT[T]),
+		"ge":      oneArg(conv, checkdesc.ValGE[T]),
+		"lt":      oneArg(conv, checkdesc.ValLT[T]),
+		"le":      oneArg(conv, checkdesc.ValLE[T]),
+		"between": twoArgs(conv, checkdesc.ValBetween[T]),
+	}
+}
+
+// int64Funcs returns the checks available for int64 values
+func int64Funcs() checkFuncs[int64] {
+	funcs := orderedFuncs(convInt64)
+	funcs["divides"] = oneArg(convInt64, checkdesc.ValDivides[int64])
+	funcs["isAMultiple"] = oneArg(convInt64, checkdesc.ValIsAMultiple[int64])
+	funcs["isEven"] = noArgs(checkdesc.ValIsEven[int64])
+	funcs["isOdd"] = noArgs(checkdesc.ValIsOdd[int64])
+	funcs["isPowerOf"] = oneArg(convInt64, checkdesc.ValIsPowerOf[int64])
+	funcs["isPrime"] = noArgs(checkdesc.ValIsPrime[int64])
+	funcs["isPerfectSquare"] = noArgs(checkdesc.ValIsPerfectSquare[int64])
+	funcs["hasAllBits"] = oneArg(convInt64, checkdesc.ValHasAllBits[int64])
+	funcs["hasNoBits"] = oneArg(convInt64, checkdesc.ValHasNoBits[int64])
+	funcs["hasExactlyOneBit"] = oneArg(convInt64,
+		checkdesc.ValHasExactlyOneBit[int64])
+
+	return funcs
+}
+
+// stringFuncs returns the checks available for string values
+func stringFuncs() checkFuncs[string] {
+	funcs := orderedFuncs(convString)
+	funcs["hasPrefix"] = oneArg(convString, checkdesc.StringHasPrefix[string])
+	funcs["hasSuffix"] = oneArg(convString, checkdesc.StringHasSuffix[string])
+	funcs["contains"] = oneArg(convString, checkdesc.StringContains[string])
+	funcs["foldedEQ"] = oneArg(convString, checkdesc.StringFoldedEQ[string])
+	funcs["matches"] = matchesBuilder
+
+	return funcs
+}
+
+// matchesBuilder builds a check that a string matches a regular expression
+func matchesBuilder(name token, args []token) (checkdesc.Ck[string], error) {
+	if err := wantArgs(name, args, 1); err != nil {
+		return checkdesc.Ck[string]{}, err
+	}
+
+	pattern, err := convString(args[0])
+	if err != nil {
+		return checkdesc.Ck[string]{}, err
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return checkdesc.Ck[string]{}, argErr(args[0], "bad regexp: %s", err)
+	}
+
+	return checkdesc.StringMatchesPattern[string](re,
+		fmt.Sprintf("a string matching %q", pattern)), nil
+}
+
+// timeFuncs returns the checks available for time.Time values
+func timeFuncs() checkFuncs[time.Time] {
+	return checkFuncs[time.Time]{
+		"eq":      oneArg(convTime, checkdesc.TimeEQ),
+		"ne":      oneArg(convTime, checkdesc.TimeNE),
+		"gt":      oneArg(convTime, checkdesc.TimeGT),
+		"ge":      oneArg(convTime, checkdesc.TimeGE),
+		"lt":      oneArg(convTime, checkdesc.TimeLT),
+		"le":      oneArg(convTime, checkdesc.TimeLE),
+		"between": twoArgs(convTime, checkdesc.TimeBetween),
+	}
+}
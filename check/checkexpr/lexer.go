@@ -0,0 +1,158 @@
+package checkexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SyntaxError records a problem with an expression and the column (starting
+// from 1) at which it was found
+type SyntaxError struct {
+	Col int
+	Msg string
+}
+
+// Error returns the error message including the column
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Col, e.Msg)
+}
+
+// tokKind records the kind of a token
+type tokKind int
+
+const (
+	tkEOF tokKind = iota
+	tkIdent
+	tkValue
+	tkString
+	tkLParen
+	tkRParen
+	tkComma
+	tkNot
+	tkAnd
+	tkOr
+)
+
+// String returns a description of the token kind for use in error messages
+func (tk tokKind) String() string {
+	switch tk {
+	case tkEOF:
+		return "the end of the expression"
+	case tkIdent:
+		return "a check name"
+	case tkValue:
+		return "a value"
+	case tkString:
+		return "a string"
+	case tkLParen:
+		return `"("`
+	case tkRParen:
+		return `")"`
+	case tkComma:
+		return `","`
+	case tkNot:
+		return `"!"`
+	case tkAnd:
+		return `"&&"`
+	case tkOr:
+		return `"||"`
+	}
+
+	return fmt.Sprintf("<bad tokKind: %d>", int(tk))
+}
+
+// token is a lexical element of an expression. The text of a string token
+// is the unquoted value. The col is the column at which the token starts.
+type token struct {
+	kind tokKind
+	text string
+	col  int
+}
+
+// isDelim returns true if the rune ends a value token
+func isDelim(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`(),!&|"`+"`", r)
+}
+
+// lex splits the expression into tokens. The final token is always a tkEOF
+// token.
+func lex(s string) ([]token, error) {
+	var toks []token
+
+	col := func(i int) int { return utf8.RuneCountInString(s[:i]) + 1 }
+
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			i += w
+		case r == '(':
+			toks = append(toks, token{kind: tkLParen, text: "(", col: col(i)})
+			i++
+		case r == ')':
+			toks = append(toks, token{kind: tkRParen, text: ")", col: col(i)})
+			i++
+		case r == ',':
+			toks = append(toks, token{kind: tkComma, text: ",", col: col(i)})
+			i++
+		case r == '!':
+			toks = append(toks, token{kind: tkNot, text: "!", col: col(i)})
+			i++
+		case strings.HasPrefix(s[i:], "&&"):
+			toks = append(toks, token{kind: tkAnd, text: "&&", col: col(i)})
+			i += 2
+		case strings.HasPrefix(s[i:], "||"):
+			toks = append(toks, token{kind: tkOr, text: "||", col: col(i)})
+			i += 2
+		case r == '"' || r == '`':
+			q, err := strconv.QuotedPrefix(s[i:])
+			if err != nil {
+				return nil, &SyntaxError{
+					Col: col(i),
+					Msg: "bad quoted string",
+				}
+			}
+
+			text, _ := strconv.Unquote(q) // a valid prefix will unquote
+			toks = append(toks, token{kind: tkString, text: text, col: col(i)})
+			i += len(q)
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(s) {
+				r, w := utf8.DecodeRuneInString(s[i:])
+				if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+					break
+				}
+
+				i += w
+			}
+
+			toks = append(toks,
+				token{kind: tkIdent, text: s[start:i], col: col(start)})
+		case unicode.IsDigit(r) || strings.ContainsRune("+-.", r):
+			start := i
+			for i < len(s) {
+				r, w := utf8.DecodeRuneInString(s[i:])
+				if isDelim(r) {
+					break
+				}
+
+				i += w
+			}
+
+			toks = append(toks,
+				token{kind: tkValue, text: s[start:i], col: col(start)})
+		default:
+			return nil, &SyntaxError{
+				Col: col(i),
+				Msg: fmt.Sprintf("unexpected character: %q", r),
+			}
+		}
+	}
+
+	return append(toks, token{kind: tkEOF, col: col(len(s))}), nil
+}
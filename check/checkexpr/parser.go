@@ -0,0 +1,208 @@
+package checkexpr
+
+import (
+	"fmt"
+
+	"github.com/nickwells/check.mod/v2/check/checkdesc"
+)
+
+// builder is the type of a function which constructs a check from the
+// arguments given in the expression. The name token is passed so that
+// errors can be reported against it.
+type builder[T any] func(name token, args []token) (checkdesc.Ck[T], error)
+
+// checkFuncs maps the names that can be used in an expression to the
+// builders of the corresponding checks
+type checkFuncs[T any] map[string]builder[T]
+
+// parser holds the state of the parse of an expression
+type parser[T any] struct {
+	toks  []token
+	pos   int
+	funcs checkFuncs[T]
+}
+
+// parse parses the expression, using the funcs to construct the named
+// checks, and returns the resulting check
+func parse[T any](s string, funcs checkFuncs[T]) (checkdesc.Ck[T], error) {
+	toks, err := lex(s)
+	if err != nil {
+		return checkdesc.Ck[T]{}, err
+	}
+
+	p := &parser[T]{toks: toks, funcs: funcs}
+
+	ck, err := p.parseOr()
+	if err != nil {
+		return checkdesc.Ck[T]{}, err
+	}
+
+	if tok := p.peek(); tok.kind != tkEOF {
+		return checkdesc.Ck[T]{}, unexpected(tok, "an operator")
+	}
+
+	return ck, nil
+}
+
+// peek returns the current token without consuming it
+func (p *parser[T]) peek() token {
+	return p.toks[p.pos]
+}
+
+// next consumes and returns the current token
+func (p *parser[T]) next() token {
+	tok := p.toks[p.pos]
+	if tok.kind != tkEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+// expect consumes the current token, returning an error if it is not of
+// the expected kind
+func (p *parser[T]) expect(kind tokKind) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, unexpected(tok, kind.String())
+	}
+
+	return tok, nil
+}
+
+// unexpected returns a SyntaxError reporting that the token was not what
+// was expected
+func unexpected(tok token, expected string) error {
+	found := tok.kind.String()
+	if tok.kind != tkEOF {
+		found += fmt.Sprintf(" (%q)", tok.text)
+	}
+
+	return &SyntaxError{
+		Col: tok.col,
+		Msg: fmt.Sprintf("expected %s but found %s", expected, found),
+	}
+}
+
+// parseOr parses a sequence of one or more terms separated by "||"
+func (p *parser[T]) parseOr() (checkdesc.Ck[T], error) {
+	return p.parseSeq(tkOr, p.parseAnd, checkdesc.Or[T])
+}
+
+// parseAnd parses a sequence of one or more terms separated by "&&"
+func (p *parser[T]) parseAnd() (checkdesc.Ck[T], error) {
+	return p.parseSeq(tkAnd, p.parseUnary, checkdesc.And[T])
+}
+
+// parseSeq parses a sequence of one or more terms, each parsed by the
+// parseTerm func, separated by the operator. If there is more than one term
+// they are combined with the combine func.
+func (p *parser[T]) parseSeq(
+	op tokKind,
+	parseTerm func() (checkdesc.Ck[T], error),
+	combine func(...checkdesc.Ck[T]) checkdesc.Ck[T],
+) (checkdesc.Ck[T], error) {
+	ck, err := parseTerm()
+	if err != nil {
+		return ck, err
+	}
+
+	terms := []checkdesc.Ck[T]{ck}
+
+	for p.peek().kind == op {
+		p.next()
+
+		ck, err := parseTerm()
+		if err != nil {
+			return ck, err
+		}
+
+		terms = append(terms, ck)
+	}
+
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+
+	return combine(terms...), nil
+}
+
+// parseUnary parses an optionally negated term
+func (p *parser[T]) parseUnary() (checkdesc.Ck[T], error) {
+	if p.peek().kind == tkNot {
+		p.next()
+
+		ck, err := p.parseUnary()
+		if err != nil {
+			return ck, err
+		}
+
+		return checkdesc.Not(ck), nil
+	}
+
+	return p.parsePrimary()
+}
+
+// parsePrimary parses either a parenthesised expression or a call of a
+// named check
+func (p *parser[T]) parsePrimary() (checkdesc.Ck[T], error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tkLParen:
+		ck, err := p.parseOr()
+		if err != nil {
+			return ck, err
+		}
+
+		if _, err := p.expect(tkRParen); err != nil {
+			return ck, err
+		}
+
+		return ck, nil
+	case tkIdent:
+		return p.parseCall(tok)
+	}
+
+	return checkdesc.Ck[T]{}, unexpected(tok, `a check name or "("`)
+}
+
+// parseCall parses the arguments of the named check and constructs it
+func (p *parser[T]) parseCall(name token) (checkdesc.Ck[T], error) {
+	b, ok := p.funcs[name.text]
+	if !ok {
+		return checkdesc.Ck[T]{}, &SyntaxError{
+			Col: name.col,
+			Msg: fmt.Sprintf("unknown check: %q", name.text),
+		}
+	}
+
+	if _, err := p.expect(tkLParen); err != nil {
+		return checkdesc.Ck[T]{}, err
+	}
+
+	var args []token
+
+	if p.peek().kind != tkRParen {
+		for {
+			tok := p.next()
+			if tok.kind != tkValue && tok.kind != tkString {
+				return checkdesc.Ck[T]{}, unexpected(tok, "an argument")
+			}
+
+			args = append(args, tok)
+
+			if p.peek().kind != tkComma {
+				break
+			}
+
+			p.next()
+		}
+	}
+
+	if _, err := p.expect(tkRParen); err != nil {
+		return checkdesc.Ck[T]{}, err
+	}
+
+	return b(name, args)
+}
@@ -0,0 +1,198 @@
+package checktest
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// Case is a test case for a check. The check should pass the value unless
+// an error is expected in which case the error should contain the given
+// strings. If ExpCheckID is set the error should be a check.CheckError
+// with that CheckID.
+type Case[T any] struct {
+	testhelper.ID
+	testhelper.ExpErr
+	Val        T
+	ExpCheckID string
+}
+
+// mkID returns a testhelper.ID recording the location of the caller of the
+// function calling mkID. This gives the location of the test case rather
+// than that of the function constructing it.
+func mkID(name string) testhelper.ID {
+	id := testhelper.ID{Name: name}
+	if _, file, line, ok := runtime.Caller(2); ok { //nolint:mnd
+		id.At = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+		id.AtFullName = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	return id
+}
+
+// Pass returns a test case for a value which the check should pass
+func Pass[T any](name string, v T) Case[T] {
+	return Case[T]{ID: mkID(name), Val: v}
+}
+
+// Fail returns a test case for a value which the check should fail. The
+// error should contain all of the shouldContain strings.
+func Fail[T any](name string, v T, shouldContain ...string) Case[T] {
+	return Case[T]{
+		ID:     mkID(name),
+		ExpErr: testhelper.MkExpErr(shouldContain...),
+		Val:    v,
+	}
+}
+
+// Run applies the check to the value in each of the test cases and reports
+// any unexpected results. It can be used from tests, benchmarks and fuzz
+// targets.
+func Run[T any](tb testing.TB, cf check.ValCk[T], cases ...Case[T]) {
+	tb.Helper()
+
+	for _, tc := range cases {
+		err := cf(tc.Val)
+		if !checkErr(tb, tc.IDStr(), err, tc.ExpErr) || err == nil ||
+			tc.ExpCheckID == "" {
+			continue
+		}
+
+		var ce *check.CheckError
+		if !errors.As(err, &ce) {
+			tb.Log(tc.IDStr())
+			tb.Errorf("\t: the error is not a *check.CheckError: %T", err)
+
+			continue
+		}
+
+		if ce.CheckID != tc.ExpCheckID {
+			tb.Log(tc.IDStr())
+			tb.Logf("\t: expected CheckID: %q", tc.ExpCheckID)
+			tb.Logf("\t:   actual CheckID: %q", ce.CheckID)
+			tb.Errorf("\t: CheckID is incorrect")
+		}
+	}
+}
+
+// checkErr reports a problem if the error is not as expected. It returns
+// true if there is no problem. The testhelper functions cannot be used as
+// they need a *testing.T.
+func checkErr(tb testing.TB, id string, err error, exp testhelper.ExpErr,
+) bool {
+	tb.Helper()
+
+	if err == nil {
+		if exp.Expected {
+			tb.Log(id)
+			tb.Error("\t: an error was expected but none was returned")
+
+			return false
+		}
+
+		return true
+	}
+
+	if !exp.Expected {
+		tb.Log(id)
+		tb.Logf("\t: unexpected error: %q", err)
+		tb.Error("\t: no error was expected")
+
+		return false
+	}
+
+	return shouldContain(tb, id, "error", err.Error(), exp.ErrShouldContain)
+}
+
+// shouldContain reports a problem if the string does not contain all of the
+// expected strings. It returns true if there is no problem.
+func shouldContain(tb testing.TB, id, name, s string, exp []string) bool {
+	tb.Helper()
+
+	ok := true
+
+	for _, e := range exp {
+		if !strings.Contains(s, e) {
+			if ok {
+				tb.Log(id)
+				tb.Logf("\t: %s: %q", name, s)
+			}
+
+			tb.Logf("\t: should contain: %q", e)
+
+			ok = false
+		}
+	}
+
+	if !ok {
+		tb.Errorf("\t: the %s does not contain all the expected strings",
+			name)
+	}
+
+	return ok
+}
+
+// PanicCase is a test case for the construction of a check. The Construct
+// func should call the function which constructs the check. It should not
+// panic unless a panic is expected in which case the panic value should
+// contain the given strings.
+type PanicCase struct {
+	testhelper.ID
+	testhelper.ExpPanic
+	Construct func()
+}
+
+// NoPanic returns a test case for the construction of a check which should
+// not panic
+func NoPanic(name string, construct func()) PanicCase {
+	return PanicCase{ID: mkID(name), Construct: construct}
+}
+
+// Panics returns a test case for the construction of a check which should
+// panic. The panic value should contain all of the shouldContain strings.
+func Panics(name string, construct func(), shouldContain ...string,
+) PanicCase {
+	return PanicCase{
+		ID:        mkID(name),
+		ExpPanic:  testhelper.MkExpPanic(shouldContain...),
+		Construct: construct,
+	}
+}
+
+// RunPanics calls the Construct func in each of the test cases and reports
+// any unexpected panics or any missing expected panics. It can be used from
+// tests, benchmarks and fuzz targets.
+func RunPanics(tb testing.TB, cases ...PanicCase) {
+	tb.Helper()
+
+	for _, tc := range cases {
+		panicked, panicVal := testhelper.PanicSafe(tc.Construct)
+
+		switch {
+		case panicked && !tc.Expected:
+			tb.Log(tc.IDStr())
+			tb.Logf("\t: unexpected panic: %v", panicVal)
+			tb.Error("\t: no panic was expected")
+		case !panicked && tc.Expected:
+			tb.Log(tc.IDStr())
+			tb.Error("\t: a panic was expected but none was seen")
+		case panicked:
+			msg, ok := panicVal.(string)
+			if !ok {
+				tb.Log(tc.IDStr())
+				tb.Errorf("\t: the panic value should be a string, not %T",
+					panicVal)
+
+				continue
+			}
+
+			shouldContain(tb, tc.IDStr(), "panic", msg, tc.ShouldContain)
+		}
+	}
+}
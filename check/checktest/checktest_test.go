@@ -0,0 +1,110 @@
+package checktest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/check.mod/v2/check/checktest"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRun(t *testing.T) {
+	checktest.Run(t, check.ValBetween(1, 10),
+		checktest.Pass("in range", 5),
+		checktest.Fail("too small", 0, "must be between 1 and 10",
+			"too small"),
+		checktest.Case[int]{
+			ID:         testhelper.MkID("too big - with CheckID"),
+			ExpErr:     testhelper.MkExpErr("too big"),
+			Val:        11,
+			ExpCheckID: "ValBetween",
+		},
+	)
+
+	checktest.Run(t, check.StringLength[string](check.ValLT(3)),
+		checktest.Pass("short", "ab"),
+		checktest.Fail("long", "abc", "the length of the string (3)"),
+	)
+}
+
+func TestRunPanics(t *testing.T) {
+	checktest.RunPanics(t,
+		checktest.NoPanic("good", func() { check.ValBetween(1, 2) }),
+		checktest.Panics("bad", func() { check.ValBetween(2, 1) },
+			"Impossible checks passed to ValBetween"),
+	)
+}
+
+func TestCaseLocation(t *testing.T) {
+	tc := checktest.Pass("where", 1)
+
+	if !strings.HasPrefix(tc.At, "checktest_test.go:") {
+		t.Errorf("the test case should record where it was created, not %q",
+			tc.At)
+	}
+}
+
+// recordTB is a testing.TB which records the failures reported to it
+// rather than failing the test
+type recordTB struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recordTB) Helper()                   {}
+func (r *recordTB) Log(_ ...any)              {}
+func (r *recordTB) Logf(_ string, _ ...any)   {}
+func (r *recordTB) Error(args ...any)         { r.fail(fmt.Sprint(args...)) }
+func (r *recordTB) Errorf(f string, a ...any) { r.fail(fmt.Sprintf(f, a...)) }
+
+func (r *recordTB) fail(msg string) {
+	r.failures = append(r.failures, strings.TrimSpace(msg))
+}
+
+func TestRunReportsFailures(t *testing.T) {
+	rtb := &recordTB{TB: t}
+
+	checktest.Run(rtb, check.ValBetween(1, 10),
+		checktest.Pass("good", 5),
+		checktest.Pass("unexpected error", 0),
+		checktest.Fail("missing error", 5, "too small"),
+		checktest.Fail("wrong error", 0, "too big"),
+		checktest.Case[int]{
+			ID:         testhelper.MkID("wrong CheckID"),
+			ExpErr:     testhelper.MkExpErr("too small"),
+			Val:        0,
+			ExpCheckID: "ValGT",
+		},
+	)
+
+	testhelper.DiffStringSlice(t, "Run", "failures", rtb.failures,
+		[]string{
+			": no error was expected",
+			": an error was expected but none was returned",
+			": the error does not contain all the expected strings",
+			": CheckID is incorrect",
+		})
+}
+
+func TestRunPanicsReportsFailures(t *testing.T) {
+	rtb := &recordTB{TB: t}
+
+	checktest.RunPanics(rtb,
+		checktest.NoPanic("good", func() { check.ValBetween(1, 2) }),
+		checktest.NoPanic("unexpected panic",
+			func() { check.ValBetween(2, 1) }),
+		checktest.Panics("missing panic",
+			func() { check.ValBetween(1, 2) }, "Impossible"),
+		checktest.Panics("wrong panic",
+			func() { check.ValBetween(2, 1) }, "no such text"),
+	)
+
+	testhelper.DiffStringSlice(t, "RunPanics", "failures", rtb.failures,
+		[]string{
+			": no panic was expected",
+			": a panic was expected but none was seen",
+			": the panic does not contain all the expected strings",
+		})
+}
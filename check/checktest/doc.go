@@ -0,0 +1,32 @@
+/*
+Package checktest provides helpers for table-driven tests of checks. They
+can be used to test any check.ValCk, including those you have written
+yourself, with only a few lines of code. For instance,
+
+	func TestIsEven(t *testing.T) {
+		checktest.Run(t, isEven,
+			checktest.Pass("even", 2),
+			checktest.Fail("odd", 3, "must be even"),
+		)
+	}
+
+will check that isEven passes the value 2 and that it fails the value 3
+with an error containing "must be even".
+
+Checks which validate their parameters when constructed and panic if they
+are bad can be tested with RunPanics:
+
+	func TestMkIsAMultiplePanic(t *testing.T) {
+		checktest.RunPanics(t,
+			checktest.NoPanic("good", func() { mkIsAMultiple(3) }),
+			checktest.Panics("zero", func() { mkIsAMultiple(0) },
+				"the divisor must not be zero"),
+		)
+	}
+
+The helpers take a testing.TB so they can be used from benchmarks and fuzz
+targets as well as from tests. They report failures in the same form as
+the testhelper package and each test case records where it was created so
+that failures are easy to find.
+*/
+package checktest
@@ -0,0 +1,141 @@
+package check
+
+import "fmt"
+
+// cmpCk returns a function that will compare the value with the limit
+// using the comparison function and will return nil if the result passes
+// the test or the error made by mkErr if not
+func cmpCk[T any](limit T, cmpFn func(a, b T) int,
+	test func(cmpResult int) bool, mkErr func(v T) error,
+) ValCk[T] {
+	return func(v T) error {
+		if test(cmpFn(v, limit)) {
+			return nil
+		}
+
+		return mkErr(v)
+	}
+}
+
+// betweenCk returns a function that will check that the value lies
+// between low and high (inclusive) using the comparison function. It will
+// return the error made by tooSmall or tooBig if the value is outside the
+// range.
+func betweenCk[T any](low, high T, cmpFn func(a, b T) int,
+	tooSmall, tooBig func(v T) error,
+) ValCk[T] {
+	return func(v T) error {
+		if cmpFn(v, low) < 0 {
+			return tooSmall(v)
+		}
+
+		if cmpFn(v, high) > 0 {
+			return tooBig(v)
+		}
+
+		return nil
+	}
+}
+
+// Comparison results passing each of the comparison checks
+func isEQ(c int) bool { return c == 0 }
+func isNE(c int) bool { return c != 0 }
+func isGT(c int) bool { return c > 0 }
+func isGE(c int) bool { return c >= 0 }
+func isLT(c int) bool { return c < 0 }
+func isLE(c int) bool { return c <= 0 }
+
+// cmpFuncCk returns a function that will check that the value compared
+// with the limit passes the test. It will panic if cmpFn is nil.
+func cmpFuncCk[T any](checkID string, limit T, cmpFn func(a, b T) int,
+	test func(cmpResult int) bool, format string,
+) ValCk[T] {
+	if cmpFn == nil {
+		panic(fmt.Sprintf("Impossible checks passed to %s:"+
+			" the comparison function is nil", checkID))
+	}
+
+	return cmpCk(limit, cmpFn, test,
+		func(v T) error {
+			return newCheckError(checkID, v, map[string]any{"limit": limit},
+				format, v, limit)
+		})
+}
+
+// ValEQFunc returns a function that will check that the value is equal to
+// the limit according to the comparison function. The comparison function
+// should return a negative number if a is less than b, a positive number
+// if a is greater than b and zero if they are equal, as cmp.Compare does;
+// so, for instance, you could use time.Time.Compare or the Cmp method of a
+// big.Int. It will panic if cmpFn is nil; this applies to all the ...Func
+// checks.
+func ValEQFunc[T any](limit T, cmpFn func(a, b T) int) ValCk[T] {
+	return cmpFuncCk("ValEQFunc", limit, cmpFn, isEQ,
+		"the value (%v) must equal %v")
+}
+
+// ValNEFunc returns a function that will check that the value is not equal
+// to the limit according to the comparison function
+func ValNEFunc[T any](limit T, cmpFn func(a, b T) int) ValCk[T] {
+	return cmpFuncCk("ValNEFunc", limit, cmpFn, isNE,
+		"the value (%v) must not equal %v")
+}
+
+// ValGTFunc returns a function that will check that the value is greater
+// than the limit according to the comparison function
+func ValGTFunc[T any](limit T, cmpFn func(a, b T) int) ValCk[T] {
+	return cmpFuncCk("ValGTFunc", limit, cmpFn, isGT,
+		"the value (%v) must be greater than %v")
+}
+
+// ValGEFunc returns a function that will check that the value is greater
+// than or equal to the limit according to the comparison function
+func ValGEFunc[T any](limit T, cmpFn func(a, b T) int) ValCk[T] {
+	return cmpFuncCk("ValGEFunc", limit, cmpFn, isGE,
+		"the value (%v) must be greater than or equal to %v")
+}
+
+// ValLTFunc returns a function that will check that the value is less than
+// the limit according to the comparison function
+func ValLTFunc[T any](limit T, cmpFn func(a, b T) int) ValCk[T] {
+	return cmpFuncCk("ValLTFunc", limit, cmpFn, isLT,
+		"the value (%v) must be less than %v")
+}
+
+// ValLEFunc returns a function that will check that the value is less than
+// or equal to the limit according to the comparison function
+func ValLEFunc[T any](limit T, cmpFn func(a, b T) int) ValCk[T] {
+	return cmpFuncCk("ValLEFunc", limit, cmpFn, isLE,
+		"the value (%v) must be less than or equal to %v")
+}
+
+// ValBetweenFunc returns a function that will check that the value lies
+// between the upper and lower limits (inclusive) according to the
+// comparison function. It will panic if cmpFn is nil or if low is not less
+// than high.
+func ValBetweenFunc[T any](low, high T, cmpFn func(a, b T) int) ValCk[T] {
+	if cmpFn == nil {
+		panic("Impossible checks passed to ValBetweenFunc:" +
+			" the comparison function is nil")
+	}
+
+	if cmpFn(low, high) >= 0 {
+		panic(fmt.Sprintf("Impossible checks passed to ValBetweenFunc:"+
+			" the lower limit (%v) must be less than the upper limit (%v)",
+			low, high))
+	}
+
+	return betweenCk(low, high, cmpFn,
+		func(v T) error {
+			return newCheckError("ValBetweenFunc", v,
+				map[string]any{"low": low, "high": high},
+				"the value (%v) must be between %v and %v - too small",
+				v, low, high)
+		},
+		func(v T) error {
+			return newCheckError("ValBetweenFunc", v,
+				map[string]any{"low": low, "high": high},
+				"the value (%v) must be between %v and %v - too big",
+				v, low, high)
+		})
+}
@@ -0,0 +1,193 @@
+package check_test
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// version is a type which is not cmp.Ordered
+type version struct {
+	major, minor int
+}
+
+// String returns the version in the usual form
+func (v version) String() string {
+	return fmt.Sprintf("v%d.%d", v.major, v.minor)
+}
+
+// cmpVersion compares versions
+func cmpVersion(a, b version) int {
+	if c := cmp.Compare(a.major, b.major); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.minor, b.minor)
+}
+
+func TestCmpFunc(t *testing.T) {
+	v1_2 := version{1, 2}
+	v1_10 := version{1, 10}
+	v2_0 := version{2, 0}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[version]
+		val       version
+	}{
+		{
+			ID:        testhelper.MkID("EQ: v1.2 == v1.2"),
+			checkFunc: check.ValEQFunc(v1_2, cmpVersion),
+			val:       v1_2,
+		},
+		{
+			ID:        testhelper.MkID("EQ: v1.10 != v1.2"),
+			checkFunc: check.ValEQFunc(v1_2, cmpVersion),
+			val:       v1_10,
+			ExpErr: testhelper.MkExpErr(
+				"the value (v1.10) must equal v1.2"),
+		},
+		{
+			ID:        testhelper.MkID("NE: v1.2 == v1.2"),
+			checkFunc: check.ValNEFunc(v1_2, cmpVersion),
+			val:       v1_2,
+			ExpErr:    testhelper.MkExpErr("must not equal v1.2"),
+		},
+		{
+			ID:        testhelper.MkID("GT: v1.10 > v1.2"),
+			checkFunc: check.ValGTFunc(v1_2, cmpVersion),
+			val:       v1_10,
+		},
+		{
+			ID:        testhelper.MkID("GT: v1.2 !> v1.2"),
+			checkFunc: check.ValGTFunc(v1_2, cmpVersion),
+			val:       v1_2,
+			ExpErr: testhelper.MkExpErr(
+				"the value (v1.2) must be greater than v1.2"),
+		},
+		{
+			ID:        testhelper.MkID("GE: v1.2 >= v1.2"),
+			checkFunc: check.ValGEFunc(v1_2, cmpVersion),
+			val:       v1_2,
+		},
+		{
+			ID:        testhelper.MkID("LT: v2.0 !< v1.10"),
+			checkFunc: check.ValLTFunc(v1_10, cmpVersion),
+			val:       v2_0,
+			ExpErr:    testhelper.MkExpErr("must be less than v1.10"),
+		},
+		{
+			ID:        testhelper.MkID("LE: v1.10 <= v1.10"),
+			checkFunc: check.ValLEFunc(v1_10, cmpVersion),
+			val:       v1_10,
+		},
+		{
+			ID:        testhelper.MkID("Between: v1.10 in [v1.2, v2.0]"),
+			checkFunc: check.ValBetweenFunc(v1_2, v2_0, cmpVersion),
+			val:       v1_10,
+		},
+		{
+			ID:        testhelper.MkID("Between: v1.0 too small"),
+			checkFunc: check.ValBetweenFunc(v1_2, v2_0, cmpVersion),
+			val:       version{1, 0},
+			ExpErr: testhelper.MkExpErr(
+				"the value (v1.0) must be between v1.2 and v2.0" +
+					" - too small"),
+		},
+		{
+			ID:        testhelper.MkID("Between: v2.1 too big"),
+			checkFunc: check.ValBetweenFunc(v1_2, v2_0, cmpVersion),
+			val:       version{2, 1},
+			ExpErr:    testhelper.MkExpErr("- too big"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestCmpFuncTime(t *testing.T) {
+	utc := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	sameInstant := utc.In(time.FixedZone("UTC+1", 3600))
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[time.Time]
+		val       time.Time
+	}{
+		{
+			ID:        testhelper.MkID("ValEQFunc: same instant"),
+			checkFunc: check.ValEQFunc(utc, time.Time.Compare),
+			val:       sameInstant,
+		},
+		{
+			ID:        testhelper.MkID("TimeEQ: same instant"),
+			checkFunc: check.TimeEQ(utc),
+			val:       sameInstant,
+		},
+		{
+			ID:        testhelper.MkID("TimeNE: same instant"),
+			checkFunc: check.TimeNE(utc),
+			val:       sameInstant,
+			ExpErr:    testhelper.MkExpErr("the time must not equal"),
+		},
+		{
+			ID:        testhelper.MkID("TimeGE: same instant"),
+			checkFunc: check.TimeGE(utc),
+			val:       sameInstant,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestCmpFuncPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("GTFunc: nil comparison func"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValGTFunc:" +
+					" the comparison function is nil"),
+			f: func() { check.ValGTFunc[version](version{}, nil) },
+		},
+		{
+			ID: testhelper.MkID("BetweenFunc: nil comparison func"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValBetweenFunc:" +
+					" the comparison function is nil"),
+			f: func() {
+				check.ValBetweenFunc[version](version{}, version{1, 0}, nil)
+			},
+		},
+		{
+			ID: testhelper.MkID("BetweenFunc: low > high"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValBetweenFunc",
+				"the lower limit (v2.0) must be less than"+
+					" the upper limit (v1.0)"),
+			f: func() {
+				check.ValBetweenFunc(version{2, 0}, version{1, 0}, cmpVersion)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
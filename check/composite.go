@@ -1,9 +1,6 @@
 package check
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 // Or returns a function that will check that the value, when passed to each
 // of the check funcs in turn, passes at least one of them. If any check
@@ -11,9 +8,7 @@ import (
 // the failing checks.
 func Or[T any](chkFuncs ...ValCk[T]) ValCk[T] {
 	return func(v T) error {
-		var compositeErr strings.Builder
-
-		sep := "either ["
+		errs := make([]error, 0, len(chkFuncs))
 
 		for _, cf := range chkFuncs {
 			err := cf(v)
@@ -21,29 +16,55 @@ func Or[T any](chkFuncs ...ValCk[T]) ValCk[T] {
 				return nil
 			}
 
-			compositeErr.WriteString(sep)
-			compositeErr.WriteString(err.Error())
-
-			sep = "] or ["
+			errs = append(errs, err)
 		}
 
-		return fmt.Errorf("%s]", compositeErr.String())
+		return orErr(v, errs)
+	}
+}
+
+// orErr returns the error for a value which has failed all of the checks
+// combined with Or
+func orErr(v any, errs []error) error {
+	text := make(msgSeq, 0, len(errs))
+	id := "either [%w]"
+
+	for _, err := range errs {
+		text = append(text, mkMsg(id, err))
+		id = " or [%w]"
+	}
+
+	return &CheckError{
+		CheckID: "Or",
+		Value:   v,
+		Msg:     text.String(),
+		Errs:    errs,
+		text:    text,
 	}
 }
 
 // And returns a function that will check that the value, when passed to each
 // of the check funcs in turn, passes all of them. The error from the first
-// check to fail is returned.
+// check to fail is returned. A failure marked as a warning does not stop
+// the checks, see Warning for details.
 func And[T any](chkFuncs ...ValCk[T]) ValCk[T] {
 	return func(v T) error {
+		var ws warnings
+
 		for _, cf := range chkFuncs {
 			err := cf(v)
-			if err != nil {
-				return err
+			if err == nil {
+				continue
 			}
+
+			if !IsWarning(err) {
+				return ws.result("And", v, err)
+			}
+
+			ws = append(ws, err)
 		}
 
-		return nil
+		return ws.result("And", v, nil)
 	}
 }
 
@@ -65,6 +86,225 @@ func Not[T any](c ValCk[T], errMsg string) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("%v should not be %s", v, errMsg)
+		return newCheckError("Not", v, map[string]any{"errMsg": errMsg},
+			"%v should not be %s", v, errMsg)
+	}
+}
+
+// countPassing applies each of the check funcs to the value and returns the
+// number that pass, the indexes of those that pass and of those that fail
+// and the errors from those that fail.
+func countPassing[T any](v T, chkFuncs []ValCk[T],
+) (passed, failed []int, errs []error) {
+	passed, failed = []int{}, []int{}
+
+	for i, cf := range chkFuncs {
+		if err := cf(v); err != nil {
+			failed = append(failed, i)
+			errs = append(errs, err)
+
+			continue
+		}
+
+		passed = append(passed, i)
+	}
+
+	return passed, failed, errs
+}
+
+// countErr returns the error for a counting check. The message starts with
+// the description of the requirement and the number of checks passed and
+// then shows the outcome of each check.
+func countErr[T any](checkID string, v T, n int, req msg,
+	passed, failed []int, errs []error,
+) error {
+	text := msgSeq{mkMsg("%s but %d did:", req, len(passed))}
+
+	pIdx, fIdx := 0, 0
+	sep := mkMsg(" ")
+
+	for i := range len(passed) + len(failed) {
+		text = append(text, sep)
+
+		if pIdx < len(passed) && passed[pIdx] == i {
+			text = append(text, mkMsg("check %d passed", i+1))
+
+			pIdx++
+		} else {
+			text = append(text, mkMsg("check %d failed [%w]", i+1, errs[fIdx]))
+
+			fIdx++
+		}
+
+		sep = mkMsg(", ")
+	}
+
+	return &CheckError{
+		CheckID: checkID,
+		Value:   v,
+		Params:  map[string]any{"n": n, "passed": passed, "failed": failed},
+		Msg:     text.String(),
+		Errs:    errs,
+		text:    text,
+	}
+}
+
+// checkCount panics if n is negative or greater than the number of checks
+func checkCount(name string, n, count int) {
+	if n < 0 || n > count {
+		panic(fmt.Sprintf("Impossible checks passed to %s:"+
+			" the count (%d) must be between 0 and the number of checks (%d)",
+			name, n, count))
+	}
+}
+
+// AtLeastN returns a function that will check that the value, when passed
+// to each of the check funcs, passes at least n of them. All of the checks
+// are applied and the error returned (if any) will show which checks passed
+// and which failed, along with the errors from those that failed. It will
+// panic if n is negative or greater than the number of checks.
+func AtLeastN[T any](n int, chkFuncs ...ValCk[T]) ValCk[T] {
+	checkCount("AtLeastN", n, len(chkFuncs))
+
+	return func(v T) error {
+		passed, failed, errs := countPassing(v, chkFuncs)
+		if len(passed) >= n {
+			return nil
+		}
+
+		return countErr("AtLeastN", v, n,
+			mkMsg("at least %d of the %d checks must pass",
+				n, len(chkFuncs)),
+			passed, failed, errs)
+	}
+}
+
+// AtMostN returns a function that will check that the value, when passed
+// to each of the check funcs, passes at most n of them. All of the checks
+// are applied and the error returned (if any) will show which checks passed
+// and which failed, along with the errors from those that failed. It will
+// panic if n is negative or greater than the number of checks.
+func AtMostN[T any](n int, chkFuncs ...ValCk[T]) ValCk[T] {
+	checkCount("AtMostN", n, len(chkFuncs))
+
+	return func(v T) error {
+		passed, failed, errs := countPassing(v, chkFuncs)
+		if len(passed) <= n {
+			return nil
+		}
+
+		return countErr("AtMostN", v, n,
+			mkMsg("at most %d of the %d checks may pass",
+				n, len(chkFuncs)),
+			passed, failed, errs)
+	}
+}
+
+// ExactlyN returns a function that will check that the value, when passed
+// to each of the check funcs, passes exactly n of them. All of the checks
+// are applied and the error returned (if any) will show which checks passed
+// and which failed, along with the errors from those that failed. It will
+// panic if n is negative or greater than the number of checks.
+func ExactlyN[T any](n int, chkFuncs ...ValCk[T]) ValCk[T] {
+	checkCount("ExactlyN", n, len(chkFuncs))
+
+	return exactlyN("ExactlyN", n, chkFuncs)
+}
+
+// exactlyN returns the ExactlyN check func reporting any errors with the
+// given checkID
+func exactlyN[T any](checkID string, n int, chkFuncs []ValCk[T]) ValCk[T] {
+	return func(v T) error {
+		passed, failed, errs := countPassing(v, chkFuncs)
+		if len(passed) == n {
+			return nil
+		}
+
+		return countErr(checkID, v, n,
+			mkMsg("exactly %d of the %d checks must pass",
+				n, len(chkFuncs)),
+			passed, failed, errs)
+	}
+}
+
+// Xor returns a function that will check that the value, when passed to
+// each of the check funcs, passes exactly one of them. It is equivalent to
+// ExactlyN(1, ...). It will panic if no checks are given.
+func Xor[T any](chkFuncs ...ValCk[T]) ValCk[T] {
+	checkCount("Xor", 1, len(chkFuncs))
+
+	return exactlyN("Xor", 1, chkFuncs)
+}
+
+// None returns a function that will check that the value, when passed to
+// each of the check funcs, passes none of them. All of the checks are
+// applied and the error returned (if any) will show which checks passed and
+// which failed, along with the errors from those that failed.
+func None[T any](chkFuncs ...ValCk[T]) ValCk[T] {
+	return func(v T) error {
+		passed, failed, errs := countPassing(v, chkFuncs)
+		if len(passed) == 0 {
+			return nil
+		}
+
+		return countErr("None", v, 0,
+			mkMsg("none of the %d checks may pass", len(chkFuncs)),
+			passed, failed, errs)
+	}
+}
+
+// If returns a function that will check the value with the cond check
+// func; if it passes then the value must pass the thenCk check func,
+// otherwise it must pass the elseCk check func. If elseCk is nil then any
+// value that fails the cond check passes. The error returned (if any) will
+// say which branch applied and, for the else branch, why the cond check
+// failed.
+func If[T any](cond, thenCk, elseCk ValCk[T]) ValCk[T] {
+	return func(v T) error {
+		condErr := cond(v)
+		if condErr == nil {
+			if err := thenCk(v); err != nil {
+				return newCheckError("If", v,
+					map[string]any{"branch": "then"},
+					"the condition check passes so the then check applies: %w",
+					err).sevOf(err)
+			}
+
+			return nil
+		}
+
+		if elseCk == nil {
+			return nil
+		}
+
+		if err := elseCk(v); err != nil {
+			return newCheckError("If", v,
+				map[string]any{"branch": "else", "condErr": condErr},
+				"the condition check fails [%s] so the else check applies: %w",
+				condErr, err).sevOf(err)
+		}
+
+		return nil
+	}
+}
+
+// Implies returns a function that will check that if the value passes the
+// antecedent check func it also passes the consequent check func. Any value
+// that fails the antecedent check passes. The error returned (if any) will
+// say that the antecedent check was passed.
+func Implies[T any](antecedent, consequent ValCk[T]) ValCk[T] {
+	return func(v T) error {
+		if antecedent(v) != nil {
+			return nil
+		}
+
+		if err := consequent(v); err != nil {
+			return newCheckError("Implies", v, nil,
+				"the value (%v) passes the antecedent check"+
+					" so it must pass the consequent check: %w",
+				v, err).sevOf(err)
+		}
+
+		return nil
 	}
 }
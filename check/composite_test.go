@@ -0,0 +1,216 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCounting(t *testing.T) {
+	gt5 := check.ValGT(5)
+	lt10 := check.ValLT(10)
+	even := check.ValIsAMultiple(2)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[int]
+		val       int
+	}{
+		{
+			ID:        testhelper.MkID("AtLeastN - 2 of 3 - pass"),
+			checkFunc: check.AtLeastN(2, gt5, lt10, even),
+			val:       7,
+		},
+		{
+			ID: testhelper.MkID("AtLeastN - 2 of 3 - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"at least 2 of the 3 checks must pass but 1 did:" +
+					" check 1 passed," +
+					" check 2 failed [the value (11) must be less than 10]," +
+					" check 3 failed [the value (11) must be a multiple of 2]"),
+			checkFunc: check.AtLeastN(2, gt5, lt10, even),
+			val:       11,
+		},
+		{
+			ID:        testhelper.MkID("AtMostN - 1 of 3 - pass"),
+			checkFunc: check.AtMostN(1, gt5, lt10, even),
+			val:       11,
+		},
+		{
+			ID: testhelper.MkID("AtMostN - 1 of 3 - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"at most 1 of the 3 checks may pass but 3 did:" +
+					" check 1 passed, check 2 passed, check 3 passed"),
+			checkFunc: check.AtMostN(1, gt5, lt10, even),
+			val:       8,
+		},
+		{
+			ID:        testhelper.MkID("ExactlyN - 2 of 3 - pass"),
+			checkFunc: check.ExactlyN(2, gt5, lt10, even),
+			val:       7,
+		},
+		{
+			ID: testhelper.MkID("ExactlyN - 2 of 3 - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"exactly 2 of the 3 checks must pass but 3 did"),
+			checkFunc: check.ExactlyN(2, gt5, lt10, even),
+			val:       8,
+		},
+		{
+			ID:        testhelper.MkID("Xor - pass"),
+			checkFunc: check.Xor(gt5, even),
+			val:       7,
+		},
+		{
+			ID: testhelper.MkID("Xor - fail - none"),
+			ExpErr: testhelper.MkExpErr(
+				"exactly 1 of the 2 checks must pass but 0 did:",
+				"check 1 failed [", "check 2 failed ["),
+			checkFunc: check.Xor(gt5, even),
+			val:       3,
+		},
+		{
+			ID: testhelper.MkID("Xor - fail - both"),
+			ExpErr: testhelper.MkExpErr(
+				"exactly 1 of the 2 checks must pass but 2 did:" +
+					" check 1 passed, check 2 passed"),
+			checkFunc: check.Xor(gt5, even),
+			val:       8,
+		},
+		{
+			ID:        testhelper.MkID("None - pass"),
+			checkFunc: check.None(gt5, even),
+			val:       3,
+		},
+		{
+			ID: testhelper.MkID("None - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"none of the 2 checks may pass but 1 did:" +
+					" check 1 failed [the value (4) must be greater than 5]," +
+					" check 2 passed"),
+			checkFunc: check.None(gt5, even),
+			val:       4,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestCountingPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("AtLeastN - too many"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to AtLeastN:",
+				"the count (3) must be between 0 and the number of checks (2)"),
+			f: func() { check.AtLeastN(3, check.ValOK[int], check.ValOK[int]) },
+		},
+		{
+			ID: testhelper.MkID("AtMostN - negative"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to AtMostN:"),
+			f: func() { check.AtMostN(-1, check.ValOK[int]) },
+		},
+		{
+			ID: testhelper.MkID("ExactlyN - too many"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ExactlyN:"),
+			f: func() { check.ExactlyN(2, check.ValOK[int]) },
+		},
+		{
+			ID: testhelper.MkID("Xor - no checks"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to Xor:"),
+			f: func() { check.Xor[int]() },
+		},
+		{
+			ID: testhelper.MkID("ExactlyN - ok"),
+			f:  func() { check.ExactlyN(1, check.ValOK[int]) },
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
+
+func TestIfImplies(t *testing.T) {
+	negative := check.ValLT(0)
+	mult10 := check.ValIsAMultiple(10)
+	lt1000 := check.ValLT(1000)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[int]
+		val       int
+	}{
+		{
+			ID:        testhelper.MkID("If - then - pass"),
+			checkFunc: check.If(negative, mult10, lt1000),
+			val:       -20,
+		},
+		{
+			ID: testhelper.MkID("If - then - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"the condition check passes so the then check applies:" +
+					" the value (-7) must be a multiple of 10"),
+			checkFunc: check.If(negative, mult10, lt1000),
+			val:       -7,
+		},
+		{
+			ID:        testhelper.MkID("If - else - pass"),
+			checkFunc: check.If(negative, mult10, lt1000),
+			val:       7,
+		},
+		{
+			ID: testhelper.MkID("If - else - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"the condition check fails" +
+					" [the value (1001) must be less than 0]" +
+					" so the else check applies:" +
+					" the value (1001) must be less than 1000"),
+			checkFunc: check.If(negative, mult10, lt1000),
+			val:       1001,
+		},
+		{
+			ID:        testhelper.MkID("If - no else"),
+			checkFunc: check.If(negative, mult10, nil),
+			val:       1001,
+		},
+		{
+			ID:        testhelper.MkID("Implies - antecedent fails"),
+			checkFunc: check.Implies(negative, mult10),
+			val:       7,
+		},
+		{
+			ID:        testhelper.MkID("Implies - both pass"),
+			checkFunc: check.Implies(negative, mult10),
+			val:       -10,
+		},
+		{
+			ID: testhelper.MkID("Implies - consequent fails"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (-7) passes the antecedent check" +
+					" so it must pass the consequent check:" +
+					" the value (-7) must be a multiple of 10"),
+			checkFunc: check.Implies(negative, mult10),
+			val:       -7,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,226 @@
+package check
+
+import "context"
+
+// ValCkCtx is the type of a check function which takes a context. This
+// allows checks which may block (for instance, because they perform I/O)
+// to be cancelled or to be given a deadline.
+type ValCkCtx[T any] func(ctx context.Context, v T) error
+
+// ToValCkCtx returns a context-aware version of the supplied check func.
+// The returned function will return the context error without calling the
+// check func if the context is already done.
+func ToValCkCtx[T any](cf ValCk[T]) ValCkCtx[T] {
+	return func(ctx context.Context, v T) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return cf(v)
+	}
+}
+
+// ToValCk returns a check function which will call the supplied
+// context-aware check func with the given context.
+func ToValCk[T any](ctx context.Context, cf ValCkCtx[T]) ValCk[T] {
+	return func(v T) error {
+		return cf(ctx, v)
+	}
+}
+
+// OrCtx is the context-aware version of Or. It will stop and return the
+// context error as soon as the context is done.
+func OrCtx[T any](chkFuncs ...ValCkCtx[T]) ValCkCtx[T] {
+	return func(ctx context.Context, v T) error {
+		errs := make([]error, 0, len(chkFuncs))
+
+		for _, cf := range chkFuncs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := cf(ctx, v)
+			if err == nil {
+				return nil
+			}
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			errs = append(errs, err)
+		}
+
+		return orErr(v, errs)
+	}
+}
+
+// AndCtx is the context-aware version of And. It will stop and return the
+// context error as soon as the context is done. As with And, a failure
+// marked as a warning does not stop the checks.
+func AndCtx[T any](chkFuncs ...ValCkCtx[T]) ValCkCtx[T] {
+	return func(ctx context.Context, v T) error {
+		var ws warnings
+
+		for _, cf := range chkFuncs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := cf(ctx, v)
+			if err == nil {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if !IsWarning(err) {
+				return ws.result("And", v, err)
+			}
+
+			ws = append(ws, err)
+		}
+
+		return ws.result("And", v, nil)
+	}
+}
+
+// SliceAllCtx is the context-aware version of SliceAll. It will stop and
+// return the context error as soon as the context is done. As with
+// SliceAll, a failure marked as a warning does not stop the checks.
+func SliceAllCtx[S ~[]E, E any](cf ValCkCtx[E]) ValCkCtx[S] {
+	return func(ctx context.Context, v S) error {
+		var ws warnings
+
+		for i, e := range v {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := cf(ctx, e)
+			if err == nil {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			entryErr := sliceEntryErr("SliceAll", v, i, e, err)
+			if entryErr.Severity != SevWarning {
+				return ws.result("SliceAll", v, entryErr)
+			}
+
+			ws = append(ws, entryErr)
+		}
+
+		return ws.result("SliceAll", v, nil)
+	}
+}
+
+// MapValAllCtx is the context-aware version of MapValAll. It will stop and
+// return the context error as soon as the context is done. As with
+// MapValAll, a failure marked as a warning does not stop the checks.
+func MapValAllCtx[M ~map[K]V, K comparable, V any](cf ValCkCtx[V],
+) ValCkCtx[M] {
+	return func(ctx context.Context, m M) error {
+		var ws warnings
+
+		for k, v := range m {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := cf(ctx, v)
+			if err == nil {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			valErr := mapValErr("MapValAll", m, k, v, err)
+			if valErr.Severity != SevWarning {
+				return ws.result("MapValAll", m, valErr)
+			}
+
+			ws = append(ws, valErr)
+		}
+
+		return ws.result("MapValAll", m, nil)
+	}
+}
+
+// SliceAggregateCtx is the context-aware version of SliceAggregate. It will
+// stop and return the context error, without calling the Test func, as soon
+// as the context is done.
+func SliceAggregateCtx[S ~[]E, E any](a Aggregator[E]) ValCkCtx[S] {
+	return func(ctx context.Context, v S) error {
+		for _, e := range v {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := a.Aggregate(e); err != nil {
+				return err
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return a.Test()
+	}
+}
+
+// MapKeyAggregateCtx is the context-aware version of MapKeyAggregate. It
+// will stop and return the context error, without calling the Test func,
+// as soon as the context is done.
+func MapKeyAggregateCtx[M ~map[K]V, K comparable, V any](a Aggregator[K],
+) ValCkCtx[M] {
+	return func(ctx context.Context, m M) error {
+		for k := range m {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := a.Aggregate(k); err != nil {
+				return err
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return a.Test()
+	}
+}
+
+// MapValAggregateCtx is the context-aware version of MapValAggregate. It
+// will stop and return the context error, without calling the Test func,
+// as soon as the context is done.
+func MapValAggregateCtx[M ~map[K]V, K comparable, V any](a Aggregator[V],
+) ValCkCtx[M] {
+	return func(ctx context.Context, m M) error {
+		for _, v := range m {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := a.Aggregate(v); err != nil {
+				return err
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return a.Test()
+	}
+}
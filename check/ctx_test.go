@@ -0,0 +1,201 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// cancelAfter returns a context-aware check which calls the cancel func
+// after it has been called n times and which then fails. It also returns a
+// pointer to the count of the number of calls.
+func cancelAfter[T any](n int, cancel context.CancelFunc,
+) (check.ValCkCtx[T], *int) {
+	calls := 0
+
+	return func(ctx context.Context, _ T) error {
+		calls++
+		if calls >= n {
+			cancel()
+			return ctx.Err()
+		}
+
+		return nil
+	}, &calls
+}
+
+func TestCtxAdapters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cf := check.ToValCkCtx(check.ValGT(5))
+	testhelper.DiffErr(t, "ToValCkCtx - pass", "error", cf(ctx, 6), nil)
+	testhelper.CheckExpErr(t, cf(ctx, 5), struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("ToValCkCtx - fail"),
+		ExpErr: testhelper.MkExpErr("must be greater than 5"),
+	})
+
+	plain := check.ToValCk(ctx, cf)
+	testhelper.DiffErr(t, "ToValCk - pass", "error", plain(6), nil)
+
+	cancel()
+
+	if err := cf(ctx, 6); !errors.Is(err, context.Canceled) {
+		t.Errorf("ToValCkCtx - cancelled: expected context.Canceled, got: %v",
+			err)
+	}
+
+	if err := plain(6); !errors.Is(err, context.Canceled) {
+		t.Errorf("ToValCk - cancelled: expected context.Canceled, got: %v",
+			err)
+	}
+}
+
+// ctxTestFunc is the type of a function applying a context-aware check to
+// some value
+type ctxTestFunc func(context.Context) error
+
+func TestCtxCombinators(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		mk       func(context.CancelFunc) (ctxTestFunc, *int)
+		expCalls int
+	}{
+		{
+			ID: testhelper.MkID("AndCtx"),
+			mk: func(cancel context.CancelFunc) (ctxTestFunc, *int) {
+				cf, calls := cancelAfter[int](2, cancel)
+				ck := check.AndCtx(cf, cf, cf, cf)
+
+				return func(ctx context.Context) error {
+					return ck(ctx, 1)
+				}, calls
+			},
+			expCalls: 2,
+		},
+		{
+			ID: testhelper.MkID("OrCtx"),
+			mk: func(cancel context.CancelFunc) (ctxTestFunc, *int) {
+				cf, calls := cancelAfter[int](1, cancel)
+				ck := check.OrCtx(cf, cf, cf)
+
+				return func(ctx context.Context) error {
+					return ck(ctx, 1)
+				}, calls
+			},
+			expCalls: 1,
+		},
+		{
+			ID: testhelper.MkID("SliceAllCtx"),
+			mk: func(cancel context.CancelFunc) (ctxTestFunc, *int) {
+				cf, calls := cancelAfter[int](3, cancel)
+				ck := check.SliceAllCtx[[]int](cf)
+
+				return func(ctx context.Context) error {
+					return ck(ctx, []int{1, 2, 3, 4, 5, 6})
+				}, calls
+			},
+			expCalls: 3,
+		},
+		{
+			ID: testhelper.MkID("MapValAllCtx"),
+			mk: func(cancel context.CancelFunc) (ctxTestFunc, *int) {
+				cf, calls := cancelAfter[int](2, cancel)
+				ck := check.MapValAllCtx[map[string]int](cf)
+
+				return func(ctx context.Context) error {
+					return ck(ctx, map[string]int{"a": 1, "b": 2, "c": 3})
+				}, calls
+			},
+			expCalls: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx, cancel := context.WithCancel(context.Background())
+		f, calls := tc.mk(cancel)
+
+		err := f(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: expected context.Canceled, got: %v", err)
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "calls", *calls, tc.expCalls)
+	}
+}
+
+func TestCtxCombinatorsNoCancel(t *testing.T) {
+	ctx := context.Background()
+
+	testhelper.DiffErr(t, "AndCtx - pass", "error",
+		check.AndCtx(
+			check.ToValCkCtx(check.ValGT(1)),
+			check.ToValCkCtx(check.ValLT(5)))(ctx, 3),
+		nil)
+
+	err := check.OrCtx(
+		check.ToValCkCtx(check.ValLT(1)),
+		check.ToValCkCtx(check.ValGT(5)))(ctx, 3)
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("OrCtx - fail"),
+		ExpErr: testhelper.MkExpErr(
+			"either [the value (3) must be less than 1]",
+			" or [the value (3) must be greater than 5]"),
+	})
+
+	err = check.SliceAllCtx[[]int](check.ToValCkCtx(check.ValLT(3)))(
+		ctx, []int{1, 2, 3})
+	testhelper.DiffString(t, "SliceAllCtx - fail", "path",
+		check.ErrPath(err).String(), "[2]")
+}
+
+func TestAggregateCtx(t *testing.T) {
+	bgCtx := context.Background()
+	cancelledCtx, cancel := context.WithCancel(bgCtx)
+	cancel()
+
+	vals := []bool{true, true, false}
+	m := map[bool]bool{true: true, false: true}
+
+	testhelper.DiffErr(t, "SliceAggregateCtx - pass", "error",
+		check.SliceAggregateCtx[[]bool](
+			check.NewCounter(check.ValEQ(true), check.ValEQ(2)))(
+			bgCtx, vals),
+		nil)
+	testhelper.DiffErr(t, "MapKeyAggregateCtx - pass", "error",
+		check.MapKeyAggregateCtx[map[bool]bool](
+			check.NewCounter(check.ValEQ(true), check.ValEQ(1)))(
+			bgCtx, m),
+		nil)
+	testhelper.DiffErr(t, "MapValAggregateCtx - pass", "error",
+		check.MapValAggregateCtx[map[bool]bool](
+			check.NewCounter(check.ValEQ(true), check.ValEQ(2)))(
+			bgCtx, m),
+		nil)
+
+	for name, err := range map[string]error{
+		"SliceAggregateCtx": check.SliceAggregateCtx[[]bool](
+			check.NewCounter(check.ValEQ(true), check.ValEQ(2)))(
+			cancelledCtx, vals),
+		"MapKeyAggregateCtx": check.MapKeyAggregateCtx[map[bool]bool](
+			check.NewCounter(check.ValEQ(true), check.ValEQ(1)))(
+			cancelledCtx, m),
+		"MapValAggregateCtx": check.MapValAggregateCtx[map[bool]bool](
+			check.NewCounter(check.ValEQ(true), check.ValEQ(2)))(
+			cancelledCtx, m),
+	} {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("%s - cancelled: expected context.Canceled, got: %v",
+				name, err)
+		}
+	}
+}
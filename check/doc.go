@@ -16,5 +16,47 @@ They are used extensively for checking command line parameters.
 Many of the types have a ...Not function that can be used to invert the
 meaning of a check. Similarly, there are ...And and ...Or functions which can
 be used to compose checks.
+
+The errors returned by the checks in this package are of type *CheckError.
+This records the value that failed the check, an identifier of the check and
+the check parameters as well as the message text. You can use errors.As to
+retrieve it.
+
+A check can be made advisory by wrapping it with Warning. The And, SliceAll,
+MapKeyAll and MapValAll checks will carry on past a warning and NewResult
+can be used to separate the warnings from any error.
+
+Floating point values which are computed are subject to rounding errors
+so, rather than ValEQ or ValBetween, you should use ValApproxEQ or
+ValApproxBetween with a Tolerance given by AbsTol, RelTol or ULPTol. The
+ValIsNaN, ValIsInf and ValIsFinite checks can be used to test for special
+values.
+
+Integers can be checked for properties such as being prime or a power of
+some number, and bitmasks (such as your own flag types) can be checked
+with ValHasAllBits, ValHasNoBits and ValHasExactlyOneBit.
+
+A statistic of the values in a slice or map, such as their mean or 95th
+percentile, can be checked by giving a Stat (from NewMean, NewPercentile
+and so on) to SliceAggregate or MapValAggregate.
+
+Values of types which are not cmp.Ordered, such as structs, can be compared
+using ValGTFunc and the other ...Func checks which take a comparison
+function like cmp.Compare.
+
+Numbers held as *big.Int, *big.Rat or *big.Float cannot be compared with
+ValGT and the like but can with BigGT and the other Big... checks.
+
+The messages are in English but translations can be registered with
+RegisterCatalogue and the message for an error in a given language can be
+found with LocalMsg (or LocalMsgCtx with the language recorded in a
+context by WithLang).
+
+The checkdesc package provides self-describing versions of the checks in
+this package. These can be combined without needing to supply the
+hand-written messages that Not, SliceAny and the like require.
+
+The checkexpr package can be used to construct checks from textual
+expressions such as "between(1, 10) && !eq(5)".
 */
 package check
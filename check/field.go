@@ -0,0 +1,133 @@
+package check
+
+// Field returns a function that will apply the supplied check func to the
+// value of a field of a struct (or any other part of a value), as returned
+// by the get func. If the check fails the error will name the field and it
+// records the field name in its Loc so that ErrPath will report it. No
+// reflection is used so this is suitable for frequently called checks. For
+// instance:
+//
+//	check.Field("Port",
+//		func(c Config) int { return c.Port },
+//		check.ValBetween(1, 65535))
+//
+// The resulting check can be combined with other checks using And, Or,
+// SliceAll and the like or with other field checks using Fields.
+func Field[S, F any](name string, get func(S) F, cf ValCk[F]) ValCk[S] {
+	return func(v S) error {
+		fv := get(v)
+
+		if err := cf(fv); err != nil {
+			return newCheckError("Field", v,
+				map[string]any{"field": name, "fieldValue": fv},
+				"the %s field is incorrect: %w", name, err).
+				at(FieldElem(name)).sevOf(err)
+		}
+
+		return nil
+	}
+}
+
+// Fields returns a function that will apply each of the supplied check
+// funcs (typically constructed with Field) to the value. Unlike And it does
+// not stop at the first failing check, every check is applied and if any of
+// them fail the returned error will wrap the errors from each of them, in
+// the order the checks were given. This means that all the fields in error
+// will be reported.
+//
+// It returns nil if all the checks pass.
+func Fields[S any](cfs ...ValCk[S]) ValCk[S] {
+	return func(v S) error {
+		var errs []error
+
+		for _, cf := range cfs {
+			if err := cf(v); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		return joinedErrs("Fields", v, errs)
+	}
+}
+
+// FieldCmp returns a function that will compare the values of two fields of
+// a struct (or any other parts of a value), as returned by the get funcs.
+// The mkCk func is called with the value of field B to construct a check
+// which is then applied to the value of field A. This allows the existing
+// ordering checks to be used so, for instance, to check that the End field
+// is after the Start field:
+//
+//	check.FieldCmp("End", func(v T) time.Time { return v.End },
+//		check.TimeGT,
+//		"Start", func(v T) time.Time { return v.Start })
+//
+// or that the Max field is greater than or equal to the Min field:
+//
+//	check.FieldCmp("Max", func(v T) int { return v.Max },
+//		check.ValGE[int],
+//		"Min", func(v T) int { return v.Min })
+//
+// If the check fails the error will name both fields and it records the
+// name of field A in its Loc.
+func FieldCmp[S, F any](
+	nameA string, getA func(S) F,
+	mkCk func(F) ValCk[F],
+	nameB string, getB func(S) F,
+) ValCk[S] {
+	return func(v S) error {
+		a, b := getA(v), getB(v)
+
+		if err := mkCk(b)(a); err != nil {
+			return newCheckError("FieldCmp", v,
+				map[string]any{
+					"fieldA": nameA, "valueA": a,
+					"fieldB": nameB, "valueB": b,
+				},
+				"the %s field is incorrect compared with the %s field: %w",
+				nameA, nameB, err).
+				at(FieldElem(nameA)).sevOf(err)
+		}
+
+		return nil
+	}
+}
+
+// FieldIf returns a function that will apply the supplied check func to
+// the named field only if the condition field passes the cond check. This
+// allows you to express conditional requirements. For instance, to check
+// that the CertFile field is not empty if the Mode field is "tls":
+//
+//	check.FieldIf("Mode", func(v T) string { return v.Mode },
+//		check.ValEQ("tls"),
+//		"CertFile", func(v T) string { return v.CertFile },
+//		check.StringLength[string](check.ValGT(0)))
+//
+// If the check fails the error will name both fields and give the value of
+// the condition field and it records the name of the checked field in its
+// Loc.
+func FieldIf[S, C, F any](
+	condName string, getCond func(S) C, cond ValCk[C],
+	name string, get func(S) F, cf ValCk[F],
+) ValCk[S] {
+	return func(v S) error {
+		c := getCond(v)
+		if cond(c) != nil {
+			return nil
+		}
+
+		fv := get(v)
+
+		if err := cf(fv); err != nil {
+			return newCheckError("FieldIf", v,
+				map[string]any{
+					"condField": condName, "condValue": c,
+					"field": name, "fieldValue": fv,
+				},
+				"the %s field is incorrect when the %s field is %v: %w",
+				name, condName, c, err).
+				at(FieldElem(name)).sevOf(err)
+		}
+
+		return nil
+	}
+}
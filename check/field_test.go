@@ -0,0 +1,205 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+type ftServer struct {
+	Host string
+	Port int
+}
+
+type ftConfig struct {
+	Name    string
+	Primary ftServer
+	Others  []ftServer
+}
+
+var (
+	ftPortCk = check.Field("Port",
+		func(s ftServer) int { return s.Port },
+		check.ValBetween(1, 65535))
+	ftHostCk = check.Field("Host",
+		func(s ftServer) string { return s.Host },
+		check.StringLength[string](check.ValGT(0)))
+	ftServerCk = check.Fields(ftHostCk, ftPortCk)
+	ftConfigCk = check.Fields(
+		check.Field("Name",
+			func(c ftConfig) string { return c.Name },
+			check.StringHasPrefix[string]("cfg-")),
+		check.Field("Primary",
+			func(c ftConfig) ftServer { return c.Primary },
+			ftServerCk),
+		check.Field("Others",
+			func(c ftConfig) []ftServer { return c.Others },
+			check.SliceAll[[]ftServer](ftServerCk)),
+	)
+)
+
+func TestField(t *testing.T) {
+	goodServer := ftServer{Host: "h", Port: 80}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val      ftConfig
+		expPaths []string
+	}{
+		{
+			ID: testhelper.MkID("good"),
+			val: ftConfig{
+				Name:    "cfg-x",
+				Primary: goodServer,
+				Others:  []ftServer{goodServer, goodServer},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad name"),
+			ExpErr: testhelper.MkExpErr(
+				"the Name field is incorrect:",
+				`"x" should have "cfg-" as a prefix`),
+			val: ftConfig{
+				Name:    "x",
+				Primary: goodServer,
+			},
+			expPaths: []string{".Name"},
+		},
+		{
+			ID: testhelper.MkID("bad everything"),
+			ExpErr: testhelper.MkExpErr(
+				"the Name field is incorrect:",
+				"the Primary field is incorrect:",
+				"the Host field is incorrect:",
+				"the Port field is incorrect:",
+				"the Others field is incorrect: list entry: 1"),
+			val: ftConfig{
+				Name:    "x",
+				Primary: ftServer{},
+				Others:  []ftServer{goodServer, {Host: "h"}},
+			},
+			expPaths: []string{".Name", ".Primary", ".Others[1].Port"},
+		},
+	}
+
+	for _, tc := range testCases {
+		err := ftConfigCk(tc.val)
+		if !testhelper.CheckExpErr(t, err, tc) || err == nil {
+			continue
+		}
+
+		var ce *check.CheckError
+		if !errors.As(err, &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error is not a *check.CheckError: %T", err)
+
+			continue
+		}
+
+		paths := []string{}
+		for _, e := range ce.Errs {
+			paths = append(paths, check.ErrPath(e).String())
+		}
+
+		testhelper.DiffStringSlice(t, tc.IDStr(), "paths", paths, tc.expPaths)
+	}
+}
+
+func TestFieldCompose(t *testing.T) {
+	ck := check.And(
+		ftPortCk,
+		check.Or(
+			check.Field("Host",
+				func(s ftServer) string { return s.Host },
+				check.ValEQ("localhost")),
+			check.Field("Port",
+				func(s ftServer) int { return s.Port },
+				check.ValGT(1024))))
+
+	testhelper.DiffErr(t, "compose - good", "error",
+		ck(ftServer{Host: "localhost", Port: 80}), nil)
+
+	err := ck(ftServer{Host: "remote", Port: 80})
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("compose - bad"),
+		ExpErr: testhelper.MkExpErr(
+			"either [the Host field is incorrect",
+			"] or [the Port field is incorrect"),
+	})
+
+	err = check.SliceAll[[]ftServer](ftPortCk)(
+		[]ftServer{{Port: 1}, {Port: 0}})
+	testhelper.DiffString(t, "compose - SliceAll", "path",
+		check.ErrPath(err).String(), "[1].Port")
+}
+
+type ftRange struct {
+	Min, Max   int
+	Start, End time.Time
+	Mode       string
+	CertFile   string
+}
+
+func TestFieldCmpAndIf(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ck := check.Fields(
+		check.FieldCmp("Max", func(r ftRange) int { return r.Max },
+			check.ValGE[int],
+			"Min", func(r ftRange) int { return r.Min }),
+		check.FieldCmp("End", func(r ftRange) time.Time { return r.End },
+			check.TimeGT,
+			"Start", func(r ftRange) time.Time { return r.Start }),
+		check.FieldIf("Mode", func(r ftRange) string { return r.Mode },
+			check.ValEQ("tls"),
+			"CertFile", func(r ftRange) string { return r.CertFile },
+			check.StringLength[string](check.ValGT(0))),
+	)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val ftRange
+	}{
+		{
+			ID: testhelper.MkID("good - no tls"),
+			val: ftRange{
+				Min: 1, Max: 1,
+				Start: start, End: start.Add(time.Second),
+				Mode: "plain",
+			},
+		},
+		{
+			ID: testhelper.MkID("good - tls"),
+			val: ftRange{
+				Min: 1, Max: 2,
+				Start: start, End: start.Add(time.Second),
+				Mode: "tls", CertFile: "cert.pem",
+			},
+		},
+		{
+			ID: testhelper.MkID("bad"),
+			ExpErr: testhelper.MkExpErr(
+				"the Max field is incorrect compared with the Min field:"+
+					" the value (1) must be greater than or equal to 2",
+				"the End field is incorrect compared with the Start field:"+
+					" the time (",
+				"the CertFile field is incorrect when the Mode field is tls:"),
+			val: ftRange{
+				Min: 2, Max: 1,
+				Start: start, End: start,
+				Mode: "tls",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, ck(tc.val), tc)
+	}
+}
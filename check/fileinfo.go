@@ -1,9 +1,10 @@
 package check
 
 import (
-	"fmt"
 	"io/fs"
 	"time"
+
+	"github.com/nickwells/check.mod/v2/check/internal/filemode"
 )
 
 // FileInfoSize returns a function that will check that the file size passes
@@ -12,8 +13,10 @@ func FileInfoSize(cf ValCk[int64]) ValCk[fs.FileInfo] {
 	return func(fi fs.FileInfo) error {
 		err := cf(fi.Size())
 		if err != nil {
-			return fmt.Errorf("the check on the size of %q failed: %w",
-				fi.Name(), err)
+			return newCheckError("FileInfoSize", fi,
+				map[string]any{"name": fi.Name(), "size": fi.Size()},
+				"the check on the size of %q failed: %w", fi.Name(), err).
+				at(FieldElem("Size")).sevOf(err)
 		}
 
 		return nil
@@ -26,8 +29,11 @@ func FileInfoPerm(cf ValCk[fs.FileMode]) ValCk[fs.FileInfo] {
 	return func(fi fs.FileInfo) error {
 		err := cf(fi.Mode())
 		if err != nil {
-			return fmt.Errorf("the file permissions of %q are incorrect: %w",
-				fi.Name(), err)
+			return newCheckError("FileInfoPerm", fi,
+				map[string]any{"name": fi.Name(), "mode": fi.Mode()},
+				"the file permissions of %q are incorrect: %w",
+				fi.Name(), err).
+				at(FieldElem("Mode")).sevOf(err)
 		}
 
 		return nil
@@ -40,8 +46,10 @@ func FileInfoName(cf ValCk[string]) ValCk[fs.FileInfo] {
 	return func(fi fs.FileInfo) error {
 		err := cf(fi.Name())
 		if err != nil {
-			return fmt.Errorf("the file name %q is incorrect: %w",
-				fi.Name(), err)
+			return newCheckError("FileInfoName", fi,
+				map[string]any{"name": fi.Name()},
+				"the file name %q is incorrect: %w", fi.Name(), err).
+				at(FieldElem("Name")).sevOf(err)
 		}
 
 		return nil
@@ -54,7 +62,9 @@ func FileInfoIsDir(fi fs.FileInfo) error {
 		return nil
 	}
 
-	return fmt.Errorf("%q should be a directory", fi.Name())
+	return newCheckError("FileInfoIsDir", fi,
+		map[string]any{"name": fi.Name()},
+		"%q should be a directory", fi.Name())
 }
 
 // FileInfoIsRegular will check that the file info describes a regular file
@@ -63,7 +73,9 @@ func FileInfoIsRegular(fi fs.FileInfo) error {
 		return nil
 	}
 
-	return fmt.Errorf("%q should be a regular file", fi.Name())
+	return newCheckError("FileInfoIsRegular", fi,
+		map[string]any{"name": fi.Name()},
+		"%q should be a regular file", fi.Name())
 }
 
 // FileInfoMode returns a function that will check that the file mode type
@@ -81,7 +93,8 @@ func FileInfoMode(m fs.FileMode) ValCk[fs.FileInfo] {
 			return nil
 		}
 
-		return fmt.Errorf(
+		return newCheckError("FileInfoMode", fi,
+			map[string]any{"name": fi.Name(), "mode": m},
 			"%q should have been %s but was %s",
 			fi.Name(), modeName(m), modeName(typeBits))
 	}
@@ -90,43 +103,7 @@ func FileInfoMode(m fs.FileMode) ValCk[fs.FileInfo] {
 // modeName reports the bits set in the file mode value in a human-readable
 // form
 func modeName(m fs.FileMode) string {
-	if m == 0 {
-		return "a regular file"
-	}
-
-	sep := ""
-	name := ""
-
-	if m&fs.ModeDir == fs.ModeDir {
-		name += sep + "a directory"
-		sep = " or "
-	}
-
-	if m&fs.ModeSymlink == fs.ModeSymlink {
-		name += sep + "a symlink"
-		sep = " or "
-	}
-
-	if m&fs.ModeNamedPipe == fs.ModeNamedPipe {
-		name += sep + "a named pipe"
-		sep = " or "
-	}
-
-	if m&fs.ModeSocket == fs.ModeSocket {
-		name += sep + "a socket"
-		sep = " or "
-	}
-
-	if m&fs.ModeDevice == fs.ModeDevice {
-		name += sep + "a device"
-		sep = " or "
-	}
-
-	if m&fs.ModeIrregular == fs.ModeIrregular {
-		name += sep + "a non-regular file"
-	}
-
-	return name
+	return filemode.TypeName(m)
 }
 
 // FileInfoModTime returns a function that will check that the file
@@ -135,9 +112,11 @@ func FileInfoModTime(cf ValCk[time.Time]) ValCk[fs.FileInfo] {
 	return func(fi fs.FileInfo) error {
 		err := cf(fi.ModTime())
 		if err != nil {
-			return fmt.Errorf(
-				"the modification time of %q is incorrect: %s",
-				fi.Name(), err)
+			return newCheckError("FileInfoModTime", fi,
+				map[string]any{"name": fi.Name(), "modTime": fi.ModTime()},
+				"the modification time of %q is incorrect: %w",
+				fi.Name(), err).
+				at(FieldElem("ModTime")).sevOf(err)
 		}
 
 		return nil
@@ -3,7 +3,6 @@
 package check
 
 import (
-	"fmt"
 	"io/fs"
 	"os"
 	"syscall"
@@ -17,8 +16,10 @@ func FileInfoOwnedBySelf(fi fs.FileInfo) error {
 		return nil
 	}
 
-	return fmt.Errorf("%q - should have been owned by the user -"+
-		" the user ID should have been %d but was %d",
+	return newCheckError("FileInfoOwnedBySelf", fi,
+		map[string]any{"name": fi.Name(), "uid": os.Getuid()},
+		"%q - should have been owned by the user -"+
+			" the user ID should have been %d but was %d",
 		fi.Name(), os.Getuid(), stat.Uid)
 }
 
@@ -32,7 +33,9 @@ func FileInfoUidEQ(uid uint32) ValCk[fs.FileInfo] { //nolint:revive
 			return nil
 		}
 
-		return fmt.Errorf("%q - the user ID should have been %d but was %d",
+		return newCheckError("FileInfoUidEQ", fi,
+			map[string]any{"name": fi.Name(), "uid": uid},
+			"%q - the user ID should have been %d but was %d",
 			fi.Name(), uid, stat.Uid)
 	}
 }
@@ -47,7 +50,9 @@ func FileInfoGidEQ(gid uint32) ValCk[fs.FileInfo] {
 			return nil
 		}
 
-		return fmt.Errorf("%q - the group ID should have been %d but was %d",
+		return newCheckError("FileInfoGidEQ", fi,
+			map[string]any{"name": fi.Name(), "gid": gid},
+			"%q - the group ID should have been %d but was %d",
 			fi.Name(), gid, stat.Gid)
 	}
 }
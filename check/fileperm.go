@@ -1,9 +1,6 @@
 package check
 
-import (
-	"fmt"
-	"io/fs"
-)
+import "io/fs"
 
 // FilePermEQ returns a function that will check that the file permission is
 // set to the value of the perms parameter
@@ -13,8 +10,9 @@ func FilePermEQ(perms fs.FileMode) ValCk[fs.FileMode] {
 			return nil
 		}
 
-		return fmt.Errorf("the permissions (%04o) should equal %04o",
-			fm.Perm(), perms)
+		return newCheckError("FilePermEQ", fm,
+			map[string]any{"perms": perms},
+			"the permissions (%04o) should equal %04o", fm.Perm(), perms)
 	}
 }
 
@@ -26,7 +24,8 @@ func FilePermHasAll(perms fs.FileMode) ValCk[fs.FileMode] {
 			return nil
 		}
 
-		return fmt.Errorf(
+		return newCheckError("FilePermHasAll", fm,
+			map[string]any{"perms": perms},
 			"the permissions (%04o) should have all of the permissions in %04o",
 			fm.Perm(), perms)
 	}
@@ -40,7 +39,8 @@ func FilePermHasNone(perms fs.FileMode) ValCk[fs.FileMode] {
 			return nil
 		}
 
-		return fmt.Errorf(
+		return newCheckError("FilePermHasNone", fm,
+			map[string]any{"perms": perms},
 			"the permissions (%04o)"+
 				" should have none of the permissions in %04o",
 			fm.Perm(), perms)
@@ -0,0 +1,309 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+// tolMode records how a Tolerance is applied
+type tolMode int
+
+const (
+	tolAbs tolMode = iota
+	tolRel
+	tolULP
+)
+
+// Tolerance describes how close two floating point values must be for them
+// to be treated as equal. Use AbsTol, RelTol or ULPTol to construct one. The
+// zero value is an absolute tolerance of zero so only equal values are
+// treated as equal.
+//
+// NaN is never within any tolerance of any value (including NaN) and an
+// infinite value is only within tolerance of an infinite value with the
+// same sign.
+type Tolerance struct {
+	mode tolMode
+	tol  float64
+	ulps uint64
+}
+
+// AbsTol returns a Tolerance allowing values to differ by no more than
+// tol. It will panic if tol is negative or NaN.
+func AbsTol(tol float64) Tolerance {
+	if !(tol >= 0) {
+		panic(fmt.Sprintf("Impossible tolerance passed to AbsTol:"+
+			" the tolerance (%v) must not be negative or NaN", tol))
+	}
+
+	return Tolerance{mode: tolAbs, tol: tol}
+}
+
+// RelTol returns a Tolerance allowing values to differ by no more than tol
+// times the larger of their magnitudes; so a tolerance of 1e-9 allows them
+// to differ in roughly the ninth significant digit. It will panic if tol is
+// negative or NaN.
+func RelTol(tol float64) Tolerance {
+	if !(tol >= 0) {
+		panic(fmt.Sprintf("Impossible tolerance passed to RelTol:"+
+			" the tolerance (%v) must not be negative or NaN", tol))
+	}
+
+	return Tolerance{mode: tolRel, tol: tol}
+}
+
+// ULPTol returns a Tolerance allowing values to differ by no more than n
+// units in the last place (ULPs). That is, there may be no more than n-1
+// representable values between them. The ULPs are those of the type being
+// checked so a float32 has much larger ULPs than a float64.
+func ULPTol(n uint64) Tolerance {
+	return Tolerance{mode: tolULP, ulps: n}
+}
+
+// String returns a description of the Tolerance
+func (tol Tolerance) String() string {
+	return tol.desc().String()
+}
+
+// desc returns a description of the Tolerance
+func (tol Tolerance) desc() msg {
+	switch tol.mode {
+	case tolRel:
+		return mkMsg("a relative tolerance of %v", tol.tol)
+	case tolULP:
+		return mkMsg("%d ULPs", tol.ulps)
+	}
+
+	return mkMsg("an absolute tolerance of %v", tol.tol)
+}
+
+// diff returns the difference between a and b, measured as the Tolerance
+// measures it, and a description of that difference. It returns true if
+// the values are within the tolerance of each other.
+func diff[T constraints.Float](a, b T, tol Tolerance) (any, msg, bool) {
+	switch tol.mode {
+	case tolRel:
+		d, rd := relDiff(float64(a), float64(b))
+		return rd, mkMsg("%v (a relative difference of %v)", d, rd),
+			!math.IsNaN(rd) && rd <= tol.tol
+	case tolULP:
+		if math.IsNaN(float64(a)) || math.IsNaN(float64(b)) {
+			return math.NaN(), mkMsg("%v", math.NaN()), false
+		}
+
+		d := ulpDiff(a, b)
+		ok := d <= tol.ulps
+
+		if a != b && (math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0)) {
+			ok = false
+		}
+
+		return d, mkMsg("%d ULPs", d), ok
+	}
+
+	d := absDiff(float64(a), float64(b))
+
+	return d, mkMsg("%v", d), !math.IsNaN(d) && d <= tol.tol
+}
+
+// absDiff returns the absolute difference between a and b. It is zero if
+// they are equal, even if they are infinite.
+func absDiff(a, b float64) float64 {
+	if a == b {
+		return 0
+	}
+
+	return math.Abs(a - b)
+}
+
+// relDiff returns the absolute difference between a and b and that
+// difference relative to the larger of their magnitudes
+func relDiff(a, b float64) (float64, float64) {
+	d := absDiff(a, b)
+	if d == 0 {
+		return 0, 0
+	}
+
+	return d, d / math.Max(math.Abs(a), math.Abs(b))
+}
+
+// ulpDiff returns the number of units in the last place between a and b;
+// that is, one more than the number of representable values between them.
+// Neither value may be NaN; an infinite value is one ULP beyond the largest
+// finite value.
+func ulpDiff[T constraints.Float](a, b T) uint64 {
+	var oa, ob int64
+
+	if reflect.TypeOf(a).Kind() == reflect.Float32 {
+		oa = ordered32(math.Float32bits(float32(a)))
+		ob = ordered32(math.Float32bits(float32(b)))
+	} else {
+		oa = ordered64(math.Float64bits(float64(a)))
+		ob = ordered64(math.Float64bits(float64(b)))
+	}
+
+	if oa < ob {
+		oa, ob = ob, oa
+	}
+
+	return uint64(oa) - uint64(ob)
+}
+
+// ordered32 maps the bits of a float32 onto an integer such that adjacent
+// floats map onto adjacent integers
+func ordered32(bits uint32) int64 {
+	const signBit = 1 << 31
+
+	if bits&signBit != 0 {
+		return -int64(bits &^ signBit)
+	}
+
+	return int64(bits)
+}
+
+// ordered64 maps the bits of a float64 onto an integer such that adjacent
+// floats map onto adjacent integers
+func ordered64(bits uint64) int64 {
+	const signBit = 1 << 63
+
+	if bits&signBit != 0 {
+		return -int64(bits &^ signBit)
+	}
+
+	return int64(bits)
+}
+
+// ValApproxEQ returns a function that will check that the value is equal
+// to the target to within the tolerance. This should be used in preference
+// to ValEQ for computed values which are subject to rounding errors.
+func ValApproxEQ[T constraints.Float](target T, tol Tolerance) ValCk[T] {
+	return func(v T) error {
+		d, dMsg, ok := diff(v, target, tol)
+		if ok {
+			return nil
+		}
+
+		return newCheckError("ValApproxEQ", v,
+			map[string]any{
+				"target":     target,
+				"tolerance":  tol,
+				"difference": d,
+			},
+			"the value (%v) must equal %v to within %v"+
+				" - the difference is %v",
+			v, target, tol.desc(), dMsg)
+	}
+}
+
+// ValApproxBetween returns a function that will check that the value is
+// between low and high (inclusive) or else within the tolerance of one of
+// them. It will panic if low is not less than high.
+func ValApproxBetween[T constraints.Float](low, high T, tol Tolerance,
+) ValCk[T] {
+	if !(low < high) {
+		panic(fmt.Sprintf("Impossible checks passed to ValApproxBetween:"+
+			" the lower limit (%v) must be less than the upper limit (%v)",
+			low, high))
+	}
+
+	return func(v T) error {
+		var (
+			limit T
+			side  string
+		)
+
+		switch {
+		case v >= low && v <= high:
+			return nil
+		case v < low:
+			limit, side = low, "too small"
+		case v > high:
+			limit, side = high, "too big"
+		default:
+			limit, side = low, "not a number"
+		}
+
+		d, dMsg, ok := diff(v, limit, tol)
+		if ok {
+			return nil
+		}
+
+		return newCheckError("ValApproxBetween", v,
+			map[string]any{
+				"low":        low,
+				"high":       high,
+				"tolerance":  tol,
+				"difference": d,
+			},
+			"the value (%v) must be between %v and %v to within %v"+
+				" - %v, the difference is %v",
+			v, low, high, tol.desc(), mkMsg(side), dMsg)
+	}
+}
+
+// ValIsNaN returns a function that will check that the value is NaN
+func ValIsNaN[T constraints.Float]() ValCk[T] {
+	return func(v T) error {
+		if math.IsNaN(float64(v)) {
+			return nil
+		}
+
+		return newCheckError("ValIsNaN", v, nil,
+			"the value (%v) must be NaN", v)
+	}
+}
+
+// ValNotNaN returns a function that will check that the value is not NaN
+func ValNotNaN[T constraints.Float]() ValCk[T] {
+	return func(v T) error {
+		if !math.IsNaN(float64(v)) {
+			return nil
+		}
+
+		return newCheckError("ValNotNaN", v, nil,
+			"the value (%v) must not be NaN", v)
+	}
+}
+
+// ValIsInf returns a function that will check that the value is infinite.
+// As with math.IsInf, if sign is greater than zero the value must be
+// positive infinity, if it is less than zero it must be negative infinity
+// and if it is zero it can be either.
+func ValIsInf[T constraints.Float](sign int) ValCk[T] {
+	var inf msg
+
+	switch {
+	case sign > 0:
+		inf = mkMsg("positive infinity")
+	case sign < 0:
+		inf = mkMsg("negative infinity")
+	default:
+		inf = mkMsg("infinite")
+	}
+
+	return func(v T) error {
+		if math.IsInf(float64(v), sign) {
+			return nil
+		}
+
+		return newCheckError("ValIsInf", v, map[string]any{"sign": sign},
+			"the value (%v) must be %v", v, inf)
+	}
+}
+
+// ValIsFinite returns a function that will check that the value is finite;
+// that is, neither infinite nor NaN
+func ValIsFinite[T constraints.Float]() ValCk[T] {
+	return func(v T) error {
+		f := float64(v)
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return nil
+		}
+
+		return newCheckError("ValIsFinite", v, nil,
+			"the value (%v) must be finite", v)
+	}
+}
@@ -0,0 +1,307 @@
+package check_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFloatApprox(t *testing.T) {
+	inf := math.Inf(1)
+	nan := math.NaN()
+	next := math.Nextafter(1, 2)
+	nextNext := math.Nextafter(next, 2)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[float64]
+		val       float64
+	}{
+		{
+			ID:        testhelper.MkID("ApproxEQ: abs: 0.1+0.2 ~= 0.3"),
+			checkFunc: check.ValApproxEQ(0.3, check.AbsTol(1e-9)),
+			val:       0.1 + 0.2,
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: abs: 1.1 !~= 1.0"),
+			checkFunc: check.ValApproxEQ(1.0, check.AbsTol(0.05)),
+			val:       1.25,
+			ExpErr: testhelper.MkExpErr(
+				"the value (1.25) must equal 1 to within" +
+					" an absolute tolerance of 0.05" +
+					" - the difference is 0.25"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: abs: zero tolerance"),
+			checkFunc: check.ValApproxEQ(1.0, check.Tolerance{}),
+			val:       1.0,
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: rel: 1e9+1 ~= 1e9"),
+			checkFunc: check.ValApproxEQ(1e9, check.RelTol(1e-8)),
+			val:       1e9 + 1,
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: rel: 110 !~= 100"),
+			checkFunc: check.ValApproxEQ(100.0, check.RelTol(0.01)),
+			val:       125,
+			ExpErr: testhelper.MkExpErr(
+				"the value (125) must equal 100 to within" +
+					" a relative tolerance of 0.01" +
+					" - the difference is 25" +
+					" (a relative difference of 0.2)"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: 1 ULP apart"),
+			checkFunc: check.ValApproxEQ(1.0, check.ULPTol(1)),
+			val:       next,
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: 2 ULPs apart"),
+			checkFunc: check.ValApproxEQ(1.0, check.ULPTol(1)),
+			val:       nextNext,
+			ExpErr: testhelper.MkExpErr("to within 1 ULPs",
+				"the difference is 2 ULPs"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: across zero"),
+			checkFunc: check.ValApproxEQ(math.Copysign(0, -1), check.ULPTol(2)),
+			val:       math.Nextafter(0, 1),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: max !~= Inf"),
+			checkFunc: check.ValApproxEQ(inf, check.ULPTol(1)),
+			val:       math.MaxFloat64,
+			ExpErr:    testhelper.MkExpErr("the difference is 1 ULPs"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: abs: Inf ~= Inf"),
+			checkFunc: check.ValApproxEQ(inf, check.AbsTol(0)),
+			val:       inf,
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: rel: -Inf !~= Inf"),
+			checkFunc: check.ValApproxEQ(inf, check.RelTol(0.5)),
+			val:       -inf,
+			ExpErr:    testhelper.MkExpErr("must equal +Inf"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: abs: NaN !~= NaN"),
+			checkFunc: check.ValApproxEQ(nan, check.AbsTol(1)),
+			val:       nan,
+			ExpErr:    testhelper.MkExpErr("the difference is NaN"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: NaN !~= 1"),
+			checkFunc: check.ValApproxEQ(1.0, check.ULPTol(1000)),
+			val:       nan,
+			ExpErr:    testhelper.MkExpErr("the difference is NaN"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxBetween: in range"),
+			checkFunc: check.ValApproxBetween(0.0, 1.0, check.AbsTol(0.1)),
+			val:       0.5,
+		},
+		{
+			ID:        testhelper.MkID("ApproxBetween: just too small"),
+			checkFunc: check.ValApproxBetween(0.0, 1.0, check.AbsTol(0.1)),
+			val:       -0.05,
+		},
+		{
+			ID:        testhelper.MkID("ApproxBetween: just too big"),
+			checkFunc: check.ValApproxBetween(0.0, 1.0, check.AbsTol(0.1)),
+			val:       1.05,
+		},
+		{
+			ID:        testhelper.MkID("ApproxBetween: too small"),
+			checkFunc: check.ValApproxBetween(0.0, 1.0, check.AbsTol(0.1)),
+			val:       -0.5,
+			ExpErr: testhelper.MkExpErr(
+				"the value (-0.5) must be between 0 and 1 to within" +
+					" an absolute tolerance of 0.1" +
+					" - too small, the difference is 0.5"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxBetween: too big"),
+			checkFunc: check.ValApproxBetween(0.0, 1.0, check.AbsTol(0.1)),
+			val:       1.5,
+			ExpErr:    testhelper.MkExpErr("too big, the difference is 0.5"),
+		},
+		{
+			ID:        testhelper.MkID("ApproxBetween: NaN"),
+			checkFunc: check.ValApproxBetween(0.0, 1.0, check.AbsTol(0.1)),
+			val:       nan,
+			ExpErr:    testhelper.MkExpErr("not a number"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestFloatApproxFloat32(t *testing.T) {
+	next := math.Nextafter32(1, 2)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[float32]
+		val       float32
+	}{
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: 1 float32 ULP apart"),
+			checkFunc: check.ValApproxEQ[float32](1, check.ULPTol(1)),
+			val:       next,
+		},
+		{
+			ID:        testhelper.MkID("ApproxEQ: ULP: 2 float32 ULPs apart"),
+			checkFunc: check.ValApproxEQ[float32](1, check.ULPTol(1)),
+			val:       math.Nextafter32(next, 2),
+			ExpErr:    testhelper.MkExpErr("the difference is 2 ULPs"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestFloatSpecial(t *testing.T) {
+	inf := math.Inf(1)
+	nan := math.NaN()
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[float64]
+		val       float64
+	}{
+		{
+			ID:        testhelper.MkID("IsNaN: NaN"),
+			checkFunc: check.ValIsNaN[float64](),
+			val:       nan,
+		},
+		{
+			ID:        testhelper.MkID("IsNaN: 1"),
+			checkFunc: check.ValIsNaN[float64](),
+			val:       1,
+			ExpErr:    testhelper.MkExpErr("the value (1) must be NaN"),
+		},
+		{
+			ID:        testhelper.MkID("NotNaN: 1"),
+			checkFunc: check.ValNotNaN[float64](),
+			val:       1,
+		},
+		{
+			ID:        testhelper.MkID("NotNaN: NaN"),
+			checkFunc: check.ValNotNaN[float64](),
+			val:       nan,
+			ExpErr:    testhelper.MkExpErr("the value (NaN) must not be NaN"),
+		},
+		{
+			ID:        testhelper.MkID("IsInf(0): -Inf"),
+			checkFunc: check.ValIsInf[float64](0),
+			val:       -inf,
+		},
+		{
+			ID:        testhelper.MkID("IsInf(0): 1"),
+			checkFunc: check.ValIsInf[float64](0),
+			val:       1,
+			ExpErr:    testhelper.MkExpErr("the value (1) must be infinite"),
+		},
+		{
+			ID:        testhelper.MkID("IsInf(1): +Inf"),
+			checkFunc: check.ValIsInf[float64](1),
+			val:       inf,
+		},
+		{
+			ID:        testhelper.MkID("IsInf(1): -Inf"),
+			checkFunc: check.ValIsInf[float64](1),
+			val:       -inf,
+			ExpErr: testhelper.MkExpErr(
+				"the value (-Inf) must be positive infinity"),
+		},
+		{
+			ID:        testhelper.MkID("IsInf(-1): +Inf"),
+			checkFunc: check.ValIsInf[float64](-1),
+			val:       inf,
+			ExpErr: testhelper.MkExpErr(
+				"the value (+Inf) must be negative infinity"),
+		},
+		{
+			ID:        testhelper.MkID("IsFinite: 1"),
+			checkFunc: check.ValIsFinite[float64](),
+			val:       1,
+		},
+		{
+			ID:        testhelper.MkID("IsFinite: Inf"),
+			checkFunc: check.ValIsFinite[float64](),
+			val:       inf,
+			ExpErr:    testhelper.MkExpErr("the value (+Inf) must be finite"),
+		},
+		{
+			ID:        testhelper.MkID("IsFinite: NaN"),
+			checkFunc: check.ValIsFinite[float64](),
+			val:       nan,
+			ExpErr:    testhelper.MkExpErr("must be finite"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestFloatPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("AbsTol: good"),
+			f:  func() { check.AbsTol(0) },
+		},
+		{
+			ID: testhelper.MkID("AbsTol: negative"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible tolerance passed to AbsTol",
+				"must not be negative or NaN"),
+			f: func() { check.AbsTol(-1) },
+		},
+		{
+			ID: testhelper.MkID("RelTol: NaN"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible tolerance passed to RelTol"),
+			f: func() { check.RelTol(math.NaN()) },
+		},
+		{
+			ID: testhelper.MkID("ApproxBetween: low == high"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValApproxBetween",
+				"the lower limit (1) must be less than the upper limit (1)"),
+			f: func() { check.ValApproxBetween(1.0, 1.0, check.AbsTol(1)) },
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
+
+func TestToleranceString(t *testing.T) {
+	testhelper.DiffString(t, "AbsTol", "String",
+		check.AbsTol(0.5).String(), "an absolute tolerance of 0.5")
+	testhelper.DiffString(t, "RelTol", "String",
+		check.RelTol(1e-9).String(), "a relative tolerance of 1e-09")
+	testhelper.DiffString(t, "ULPTol", "String",
+		check.ULPTol(4).String(), "4 ULPs")
+}
@@ -36,6 +36,36 @@ func TestInt64(t *testing.T) {
 			val:       21,
 			ExpErr:    testhelper.MkExpErr("must be a multiple of"),
 		},
+		{
+			ID:        testhelper.MkID("Divides: 0 does not divide 60"),
+			checkFunc: check.ValDivides(int64(60)),
+			val:       0,
+			ExpErr: testhelper.MkExpErr(
+				"the value (0) must be a divisor of 60"),
+		},
+		{
+			ID:        testhelper.MkID("Divides: 0 divides 0"),
+			checkFunc: check.ValDivides(int64(0)),
+			val:       0,
+		},
+		{
+			ID:        testhelper.MkID("Divides: 5 does not divide 0"),
+			checkFunc: check.ValDivides(int64(5)),
+			val:       0,
+			ExpErr:    testhelper.MkExpErr("must be a divisor of 5"),
+		},
+		{
+			ID:        testhelper.MkID("IsAMultiple: 0 is a multiple of 0"),
+			checkFunc: check.ValIsAMultiple(int64(0)),
+			val:       0,
+		},
+		{
+			ID:        testhelper.MkID("IsAMultiple: 5 is not a multiple of 0"),
+			checkFunc: check.ValIsAMultiple(int64(0)),
+			val:       5,
+			ExpErr: testhelper.MkExpErr(
+				"the value (5) must be a multiple of 0"),
+		},
 	}
 
 	for _, tc := range testCases {
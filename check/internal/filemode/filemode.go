@@ -0,0 +1,41 @@
+// Package filemode describes the file type bits of a file mode. It is
+// shared by the check and checkdesc packages so that their descriptions of
+// file types are the same.
+package filemode
+
+import (
+	"io/fs"
+	"strings"
+)
+
+// typeNames maps the file type bits to a description
+var typeNames = []struct {
+	bit  fs.FileMode
+	name string
+}{
+	{fs.ModeDir, "a directory"},
+	{fs.ModeSymlink, "a symlink"},
+	{fs.ModeNamedPipe, "a named pipe"},
+	{fs.ModeSocket, "a socket"},
+	{fs.ModeDevice, "a device"},
+	{fs.ModeIrregular, "a non-regular file"},
+}
+
+// TypeName reports the file type bits set in the file mode value in a
+// human-readable form. If more than one bit is set the names are joined
+// with "or".
+func TypeName(m fs.FileMode) string {
+	if m == 0 {
+		return "a regular file"
+	}
+
+	names := []string{}
+
+	for _, tn := range typeNames {
+		if m&tn.bit == tn.bit {
+			names = append(names, tn.name)
+		}
+	}
+
+	return strings.Join(names, " or ")
+}
@@ -0,0 +1,146 @@
+// Package keyorder puts the keys of a map into a reproducible order so that
+// the errors found when checking every entry in a map are always given in
+// the same order.
+//
+// Keys which are numbers sort first, by value, followed by strings, also by
+// value. Any other keys sort after these by their type and then by their
+// Go-syntax representation (as given by the %#v verb). Keys which still
+// compare equal, such as floating point NaNs, are in no particular order.
+package keyorder
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Entry holds a key from a map and its value
+type Entry[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+// Keys returns the keys of the map in order
+func Keys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.SortFunc(keys, func(a, b K) int {
+		return Compare(reflect.ValueOf(a), reflect.ValueOf(b))
+	})
+
+	return keys
+}
+
+// Entries returns the entries of the map ordered by key. This should be
+// used rather than looking up the values of the keys returned by Keys as a
+// key which is not equal to itself (such as a floating point NaN) cannot be
+// used to find its value.
+func Entries[M ~map[K]V, K comparable, V any](m M) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{Key: k, Val: v})
+	}
+
+	slices.SortFunc(entries, func(a, b Entry[K, V]) int {
+		return Compare(reflect.ValueOf(a.Key), reflect.ValueOf(b.Key))
+	})
+
+	return entries
+}
+
+// keyClass classifies a map key for sorting purposes
+type keyClass int
+
+const (
+	kcNumber keyClass = iota
+	kcString
+	kcOther
+)
+
+// classifyKey returns the keyClass of the value
+func classifyKey(v reflect.Value) keyClass {
+	switch {
+	case v.CanInt(), v.CanUint(), v.CanFloat():
+		return kcNumber
+	case v.Kind() == reflect.String:
+		return kcString
+	default:
+		return kcOther
+	}
+}
+
+// cmpNumbers compares the two numeric values. Values of different numeric
+// kinds are compared as float64 values.
+func cmpNumbers(a, b reflect.Value) int {
+	switch {
+	case a.CanInt() && b.CanInt():
+		return cmp.Compare(a.Int(), b.Int())
+	case a.CanUint() && b.CanUint():
+		return cmp.Compare(a.Uint(), b.Uint())
+	}
+
+	return cmp.Compare(asFloat(a), asFloat(b))
+}
+
+// asFloat returns the numeric value as a float64
+func asFloat(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	}
+
+	return v.Float()
+}
+
+// Compare compares the two map keys returning a negative value if a should
+// sort before b, a positive value if after and zero otherwise. Keys held in
+// an interface are compared by the values they hold.
+func Compare(a, b reflect.Value) int {
+	a, b = underlying(a), underlying(b)
+
+	aClass, bClass := classifyKey(a), classifyKey(b)
+	if aClass != bClass {
+		return cmp.Compare(aClass, bClass)
+	}
+
+	var c int
+
+	switch aClass {
+	case kcNumber:
+		c = cmpNumbers(a, b)
+	case kcString:
+		c = cmp.Compare(a.String(), b.String())
+	}
+
+	// keys of different types or which print the same with the %v verb
+	// are still distinguished
+	return cmp.Or(c,
+		cmp.Compare(typeName(a), typeName(b)),
+		cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b)))
+}
+
+// underlying returns the value held by an interface value. Any other value
+// (or a nil interface) is returned unchanged.
+func underlying(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		return v.Elem()
+	}
+
+	return v
+}
+
+// typeName returns the name of the type of the value, or the empty string
+// if the value is invalid (the key is a nil interface)
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+
+	return v.Type().String()
+}
@@ -0,0 +1,55 @@
+package keyorder_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check/internal/keyorder"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestKeys(t *testing.T) {
+	testhelper.DiffSlice(t, "int keys", "keys",
+		keyorder.Keys(map[int]bool{10: true, -2: true, 9: true, 0: true}),
+		[]int{-2, 0, 9, 10})
+	testhelper.DiffSlice(t, "string keys", "keys",
+		keyorder.Keys(map[string]bool{"b": true, "a": true, "c": true}),
+		[]string{"a", "b", "c"})
+	testhelper.DiffSlice(t, "mixed keys", "keys",
+		keyorder.Keys(
+			map[any]bool{"b": true, 2: true, 1.5: true, "a": true}),
+		[]any{1.5, 2, "a", "b"})
+}
+
+func TestKeysTiebreak(t *testing.T) {
+	// these keys are all numerically equal and so are ordered by type
+	numKeys := map[any]bool{int8(1): true, 1: true, 1.0: true}
+	// these keys print the same with the %v verb
+	arrKeys := map[[2]string]bool{{"a b", "c"}: true, {"a", "b c"}: true}
+
+	for range 20 {
+		testhelper.DiffSlice(t, "equal numbers", "keys",
+			keyorder.Keys(numKeys), []any{1.0, 1, int8(1)})
+		testhelper.DiffSlice(t, "same printed form", "keys",
+			keyorder.Keys(arrKeys),
+			[][2]string{{"a b", "c"}, {"a", "b c"}})
+	}
+}
+
+func TestEntries(t *testing.T) {
+	testhelper.DiffSlice(t, "int keys", "entries",
+		keyorder.Entries(map[int]string{10: "x", -2: "y", 0: "z"}),
+		[]keyorder.Entry[int, string]{
+			{Key: -2, Val: "y"},
+			{Key: 0, Val: "z"},
+			{Key: 10, Val: "x"},
+		})
+
+	nanEntries := keyorder.Entries(map[float64]int{math.NaN(): 5, 1: 2})
+	testhelper.DiffInt(t, "NaN key", "entry count", len(nanEntries), 2)
+
+	if len(nanEntries) == 2 {
+		testhelper.DiffInt(t, "NaN key", "NaN value", nanEntries[0].Val, 5)
+		testhelper.DiffInt(t, "NaN key", "other value", nanEntries[1].Val, 2)
+	}
+}
@@ -0,0 +1,464 @@
+package check
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Bound is one end of an Interval. If Unbounded is set the interval extends
+// indefinitely in that direction and the other fields are ignored.
+type Bound[T any] struct {
+	Val       T
+	Inclusive bool
+	Unbounded bool
+}
+
+// Interval is a range of values between the Low and High bounds
+type Interval[T any] struct {
+	Low  Bound[T]
+	High Bound[T]
+}
+
+// IntervalGT returns the Interval of values greater than v
+func IntervalGT[T any](v T) Interval[T] {
+	return Interval[T]{Low: Bound[T]{Val: v}, High: Bound[T]{Unbounded: true}}
+}
+
+// IntervalGE returns the Interval of values greater than or equal to v
+func IntervalGE[T any](v T) Interval[T] {
+	return Interval[T]{
+		Low:  Bound[T]{Val: v, Inclusive: true},
+		High: Bound[T]{Unbounded: true},
+	}
+}
+
+// IntervalLT returns the Interval of values less than v
+func IntervalLT[T any](v T) Interval[T] {
+	return Interval[T]{Low: Bound[T]{Unbounded: true}, High: Bound[T]{Val: v}}
+}
+
+// IntervalLE returns the Interval of values less than or equal to v
+func IntervalLE[T any](v T) Interval[T] {
+	return Interval[T]{
+		Low:  Bound[T]{Unbounded: true},
+		High: Bound[T]{Val: v, Inclusive: true},
+	}
+}
+
+// IntervalEQ returns the Interval holding just the value v
+func IntervalEQ[T any](v T) Interval[T] {
+	return IntervalBetween(v, v)
+}
+
+// IntervalBetween returns the Interval of values between low and high
+// inclusive
+func IntervalBetween[T any](low, high T) Interval[T] {
+	return Interval[T]{
+		Low:  Bound[T]{Val: low, Inclusive: true},
+		High: Bound[T]{Val: high, Inclusive: true},
+	}
+}
+
+// IntervalAll returns the Interval holding every value
+func IntervalAll[T any]() Interval[T] {
+	return Interval[T]{
+		Low:  Bound[T]{Unbounded: true},
+		High: Bound[T]{Unbounded: true},
+	}
+}
+
+// String returns a string representation of the Interval in the usual
+// mathematical notation, for instance "[1, 5)"
+func (ivl Interval[T]) String() string {
+	var s strings.Builder
+
+	if ivl.Low.Unbounded {
+		s.WriteString("(-inf")
+	} else {
+		if ivl.Low.Inclusive {
+			s.WriteString("[")
+		} else {
+			s.WriteString("(")
+		}
+
+		fmt.Fprint(&s, ivl.Low.Val)
+	}
+
+	s.WriteString(", ")
+
+	if ivl.High.Unbounded {
+		s.WriteString("+inf)")
+	} else {
+		fmt.Fprint(&s, ivl.High.Val)
+
+		if ivl.High.Inclusive {
+			s.WriteString("]")
+		} else {
+			s.WriteString(")")
+		}
+	}
+
+	return s.String()
+}
+
+// order records how values of a type are ordered. For integer types the
+// next and prev funcs return the adjacent values and false if there is no
+// such value; they are nil for other types.
+type order[T any] struct {
+	cmp  func(a, b T) int
+	next func(T) (T, bool)
+	prev func(T) (T, bool)
+}
+
+// IntervalSet is a set of values represented as a sorted list of disjoint
+// Intervals. It is used to record the values accepted by a check.
+//
+// For integer types the set takes account of the fact that there are no
+// values between adjacent integers, so that, for instance, the values
+// greater than 5 and less than 6 form an empty set, and of the limits of
+// the type, so that the values of a uint greater than or equal to 0 form
+// the set of all values. Floating point NaN values are not considered.
+//
+// An IntervalSet should be created with NewIntervalSet or
+// NewIntervalSetFunc; the zero value is not usable.
+type IntervalSet[T any] struct {
+	ord  *order[T]
+	ivls []Interval[T]
+}
+
+// NewIntervalSet returns the IntervalSet holding the values in any of the
+// intervals
+func NewIntervalSet[T cmp.Ordered](ivls ...Interval[T]) IntervalSet[T] {
+	return NewIntervalSetFunc(cmp.Compare[T], ivls...)
+}
+
+// NewIntervalSetFunc returns the IntervalSet holding the values in any of
+// the intervals. The values are ordered using the cmpFn which should
+// return a negative number if a < b, a positive number if a > b and zero
+// if they are equal. For instance, for time.Time values you could use
+// time.Time.Compare.
+func NewIntervalSetFunc[T any](cmpFn func(a, b T) int, ivls ...Interval[T],
+) IntervalSet[T] {
+	ord := &order[T]{cmp: cmpFn}
+	ord.next, ord.prev = intSteps[T]()
+
+	return ord.mkSet(ivls)
+}
+
+// intSteps returns funcs giving the next and previous values if T is an
+// integer type and nil otherwise
+func intSteps[T any]() (next, prev func(T) (T, bool)) {
+	var zero T
+
+	t := reflect.TypeOf(zero)
+	if t == nil {
+		return nil, nil
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return intStep[T](1), intStep[T](-1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return uintStep[T](true), uintStep[T](false)
+	}
+
+	return nil, nil
+}
+
+// intStep returns a func that adds the step to a signed integer value
+func intStep[T any](step int64) func(T) (T, bool) {
+	return func(v T) (T, bool) {
+		rv := reflect.ValueOf(&v).Elem()
+
+		n := rv.Int()
+		if (step > 0 && n == math.MaxInt64) ||
+			(step < 0 && n == math.MinInt64) ||
+			rv.OverflowInt(n+step) {
+			return v, false
+		}
+
+		rv.SetInt(n + step)
+
+		return v, true
+	}
+}
+
+// uintStep returns a func that adds or subtracts one from an unsigned
+// integer value
+func uintStep[T any](up bool) func(T) (T, bool) {
+	return func(v T) (T, bool) {
+		rv := reflect.ValueOf(&v).Elem()
+
+		n := rv.Uint()
+		if up {
+			if n == math.MaxUint64 || rv.OverflowUint(n+1) {
+				return v, false
+			}
+
+			rv.SetUint(n + 1)
+		} else {
+			if n == 0 {
+				return v, false
+			}
+
+			rv.SetUint(n - 1)
+		}
+
+		return v, true
+	}
+}
+
+// tidy returns the interval with the bounds of an integer interval made
+// inclusive and those at the limits of the type made unbounded. It returns
+// false if the interval is empty.
+func (o *order[T]) tidy(ivl Interval[T]) (Interval[T], bool) {
+	if o.next != nil {
+		var ok bool
+
+		if ivl.Low, ok = o.tidyBound(ivl.Low, o.next, o.prev); !ok {
+			return ivl, false
+		}
+
+		if ivl.High, ok = o.tidyBound(ivl.High, o.prev, o.next); !ok {
+			return ivl, false
+		}
+	}
+
+	if ivl.Low.Unbounded || ivl.High.Unbounded {
+		return ivl, true
+	}
+
+	c := o.cmp(ivl.Low.Val, ivl.High.Val)
+
+	return ivl, c < 0 || (c == 0 && ivl.Low.Inclusive && ivl.High.Inclusive)
+}
+
+// tidyBound returns the bound made inclusive and, if it is at the limit of
+// the type, unbounded. The inward func moves a value towards the inside of
+// the interval and outward moves it away. It returns false if there is no
+// value within the bound.
+func (o *order[T]) tidyBound(b Bound[T], inward, outward func(T) (T, bool),
+) (Bound[T], bool) {
+	if b.Unbounded {
+		return b, true
+	}
+
+	if !b.Inclusive {
+		v, ok := inward(b.Val)
+		if !ok {
+			return b, false
+		}
+
+		b = Bound[T]{Val: v, Inclusive: true}
+	}
+
+	if _, ok := outward(b.Val); !ok {
+		b = Bound[T]{Unbounded: true}
+	}
+
+	return b, true
+}
+
+// cmpLow compares two low bounds
+func (o *order[T]) cmpLow(a, b Bound[T]) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return -1
+	case b.Unbounded:
+		return 1
+	}
+
+	if c := o.cmp(a.Val, b.Val); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return -1
+	}
+
+	return 1
+}
+
+// cmpHigh compares two high bounds
+func (o *order[T]) cmpHigh(a, b Bound[T]) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return 1
+	case b.Unbounded:
+		return -1
+	}
+
+	if c := o.cmp(a.Val, b.Val); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return 1
+	}
+
+	return -1
+}
+
+// joins returns true if an interval ending at the high bound and one
+// starting at the low bound overlap or are adjacent
+func (o *order[T]) joins(high, low Bound[T]) bool {
+	if high.Unbounded || low.Unbounded {
+		return true
+	}
+
+	c := o.cmp(low.Val, high.Val)
+	if c < 0 || (c == 0 && (high.Inclusive || low.Inclusive)) {
+		return true
+	}
+
+	if o.next != nil {
+		if v, ok := o.next(high.Val); ok && o.cmp(v, low.Val) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mkSet returns the IntervalSet holding the values in any of the intervals
+func (o *order[T]) mkSet(ivls []Interval[T]) IntervalSet[T] {
+	tidied := make([]Interval[T], 0, len(ivls))
+
+	for _, ivl := range ivls {
+		if ivl, ok := o.tidy(ivl); ok {
+			tidied = append(tidied, ivl)
+		}
+	}
+
+	slices.SortFunc(tidied, func(a, b Interval[T]) int {
+		return o.cmpLow(a.Low, b.Low)
+	})
+
+	merged := make([]Interval[T], 0, len(tidied))
+
+	for _, ivl := range tidied {
+		last := len(merged) - 1
+		if last < 0 || !o.joins(merged[last].High, ivl.Low) {
+			merged = append(merged, ivl)
+
+			continue
+		}
+
+		if o.cmpHigh(ivl.High, merged[last].High) > 0 {
+			merged[last].High = ivl.High
+		}
+	}
+
+	return IntervalSet[T]{ord: o, ivls: merged}
+}
+
+// Intervals returns the intervals making up the set, in order
+func (s IntervalSet[T]) Intervals() []Interval[T] {
+	return slices.Clone(s.ivls)
+}
+
+// IsEmpty returns true if the set holds no values
+func (s IntervalSet[T]) IsEmpty() bool {
+	return len(s.ivls) == 0
+}
+
+// IsAll returns true if the set holds every value
+func (s IntervalSet[T]) IsAll() bool {
+	return len(s.ivls) == 1 &&
+		s.ivls[0].Low.Unbounded && s.ivls[0].High.Unbounded
+}
+
+// Contains returns true if the value is in the set
+func (s IntervalSet[T]) Contains(v T) bool {
+	pt := Bound[T]{Val: v, Inclusive: true}
+
+	for _, ivl := range s.ivls {
+		if s.ord.cmpLow(ivl.Low, pt) <= 0 && s.ord.cmpHigh(ivl.High, pt) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Union returns the set of values in either set
+func (s IntervalSet[T]) Union(other IntervalSet[T]) IntervalSet[T] {
+	return s.ord.mkSet(slices.Concat(s.ivls, other.ivls))
+}
+
+// Intersect returns the set of values in both sets
+func (s IntervalSet[T]) Intersect(other IntervalSet[T]) IntervalSet[T] {
+	var ivls []Interval[T]
+
+	for _, a := range s.ivls {
+		for _, b := range other.ivls {
+			ivl := a
+			if s.ord.cmpLow(b.Low, ivl.Low) > 0 {
+				ivl.Low = b.Low
+			}
+
+			if s.ord.cmpHigh(b.High, ivl.High) < 0 {
+				ivl.High = b.High
+			}
+
+			ivls = append(ivls, ivl)
+		}
+	}
+
+	return s.ord.mkSet(ivls)
+}
+
+// Complement returns the set of values not in the set
+func (s IntervalSet[T]) Complement() IntervalSet[T] {
+	ivls := make([]Interval[T], 0, len(s.ivls)+1)
+	low := Bound[T]{Unbounded: true}
+
+	for _, ivl := range s.ivls {
+		if !ivl.Low.Unbounded {
+			ivls = append(ivls, Interval[T]{
+				Low: low,
+				High: Bound[T]{
+					Val:       ivl.Low.Val,
+					Inclusive: !ivl.Low.Inclusive,
+				},
+			})
+		}
+
+		if ivl.High.Unbounded {
+			return s.ord.mkSet(ivls)
+		}
+
+		low = Bound[T]{Val: ivl.High.Val, Inclusive: !ivl.High.Inclusive}
+	}
+
+	ivls = append(ivls, Interval[T]{Low: low, High: Bound[T]{Unbounded: true}})
+
+	return s.ord.mkSet(ivls)
+}
+
+// String returns a string representation of the set, for instance
+// "{(-inf, 1), [5, 7]}"
+func (s IntervalSet[T]) String() string {
+	strs := make([]string, 0, len(s.ivls))
+	for _, ivl := range s.ivls {
+		strs = append(strs, ivl.String())
+	}
+
+	return "{" + strings.Join(strs, ", ") + "}"
+}
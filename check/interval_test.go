@@ -0,0 +1,147 @@
+package check_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestIntervalSetInt(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		s        check.IntervalSet[int]
+		expStr   string
+		expEmpty bool
+		expAll   bool
+	}{
+		{
+			ID:       testhelper.MkID("empty"),
+			s:        check.NewIntervalSet[int](),
+			expStr:   "{}",
+			expEmpty: true,
+		},
+		{
+			ID:     testhelper.MkID("all"),
+			s:      check.NewIntervalSet(check.IntervalAll[int]()),
+			expStr: "{(-inf, +inf)}",
+			expAll: true,
+		},
+		{
+			ID:     testhelper.MkID("GT - made inclusive"),
+			s:      check.NewIntervalSet(check.IntervalGT(5)),
+			expStr: "{[6, +inf)}",
+		},
+		{
+			ID: testhelper.MkID("GT and LT - no integers between"),
+			s: check.NewIntervalSet(check.IntervalGT(5)).Intersect(
+				check.NewIntervalSet(check.IntervalLT(6))),
+			expStr:   "{}",
+			expEmpty: true,
+		},
+		{
+			ID: testhelper.MkID("union - adjacent intervals merged"),
+			s: check.NewIntervalSet(
+				check.IntervalBetween(1, 3),
+				check.IntervalBetween(4, 6),
+				check.IntervalEQ(9)),
+			expStr: "{[1, 6], [9, 9]}",
+		},
+		{
+			ID: testhelper.MkID("complement"),
+			s: check.NewIntervalSet(
+				check.IntervalBetween(1, 3),
+				check.IntervalEQ(9)).Complement(),
+			expStr: "{(-inf, 0], [4, 8], [10, +inf)}",
+		},
+		{
+			ID: testhelper.MkID("LT or GE - all"),
+			s: check.NewIntervalSet(check.IntervalLT(3)).Union(
+				check.NewIntervalSet(check.IntervalGE(3))),
+			expStr: "{(-inf, +inf)}",
+			expAll: true,
+		},
+		{
+			ID: testhelper.MkID("intersect"),
+			s: check.NewIntervalSet(
+				check.IntervalBetween(1, 10),
+				check.IntervalBetween(20, 30)).Intersect(
+				check.NewIntervalSet(check.IntervalBetween(5, 25))),
+			expStr: "{[5, 10], [20, 25]}",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "set", tc.s.String(), tc.expStr)
+		testhelper.DiffBool(t, tc.IDStr(), "IsEmpty", tc.s.IsEmpty(),
+			tc.expEmpty)
+		testhelper.DiffBool(t, tc.IDStr(), "IsAll", tc.s.IsAll(), tc.expAll)
+	}
+}
+
+func TestIntervalSetLimits(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		s      check.IntervalSet[uint8]
+		expStr string
+		expAll bool
+	}{
+		{
+			ID:     testhelper.MkID("GE 0 - all"),
+			s:      check.NewIntervalSet(check.IntervalGE[uint8](0)),
+			expStr: "{(-inf, +inf)}",
+			expAll: true,
+		},
+		{
+			ID:     testhelper.MkID("GT 255 - empty"),
+			s:      check.NewIntervalSet(check.IntervalGT[uint8](255)),
+			expStr: "{}",
+		},
+		{
+			ID:     testhelper.MkID("LT 1"),
+			s:      check.NewIntervalSet(check.IntervalLT[uint8](1)),
+			expStr: "{(-inf, 0]}",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "set", tc.s.String(), tc.expStr)
+		testhelper.DiffBool(t, tc.IDStr(), "IsAll", tc.s.IsAll(), tc.expAll)
+	}
+}
+
+func TestIntervalSetFloat(t *testing.T) {
+	s := check.NewIntervalSet(check.IntervalGT(5.0)).Intersect(
+		check.NewIntervalSet(check.IntervalLT(6.0)))
+	testhelper.DiffString(t, "float GT 5, LT 6", "set", s.String(),
+		"{(5, 6)}")
+	testhelper.DiffString(t, "float GT 5, LT 6", "complement",
+		s.Complement().String(), "{(-inf, 5], [6, +inf)}")
+
+	for _, v := range []struct {
+		val float64
+		exp bool
+	}{{5, false}, {5.5, true}, {6, false}} {
+		testhelper.DiffBool(t, "float GT 5, LT 6", "Contains",
+			s.Contains(v.val), v.exp)
+	}
+}
+
+func TestIntervalSetFunc(t *testing.T) {
+	t1 := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	s := check.NewIntervalSetFunc(time.Time.Compare,
+		check.IntervalGE(t2)).Intersect(
+		check.NewIntervalSetFunc(time.Time.Compare, check.IntervalLE(t1)))
+	testhelper.DiffBool(t, "after t2 and before t1", "IsEmpty",
+		s.IsEmpty(), true)
+
+	s = check.NewIntervalSetFunc(time.Time.Compare,
+		check.IntervalBetween(t1, t2))
+	testhelper.DiffBool(t, "between t1 and t2", "Contains",
+		s.Contains(t1.Add(time.Minute)), true)
+	testhelper.DiffBool(t, "between t1 and t2", "Contains",
+		s.Contains(t2.Add(time.Minute)), false)
+}
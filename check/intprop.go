@@ -0,0 +1,174 @@
+package check
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ValIsEven returns a function that will check that the value is even
+func ValIsEven[T constraints.Integer]() ValCk[T] {
+	return func(v T) error {
+		if v%2 == 0 {
+			return nil
+		}
+
+		return newCheckError("ValIsEven", v, nil,
+			"the value (%d) must be even", v)
+	}
+}
+
+// ValIsOdd returns a function that will check that the value is odd
+func ValIsOdd[T constraints.Integer]() ValCk[T] {
+	return func(v T) error {
+		if v%2 != 0 {
+			return nil
+		}
+
+		return newCheckError("ValIsOdd", v, nil,
+			"the value (%d) must be odd", v)
+	}
+}
+
+// ValIsPowerOf returns a function that will check that the value is a
+// power of b (including b to the power 0, which is 1). It will panic if b
+// is less than 2.
+func ValIsPowerOf[T constraints.Integer](b T) ValCk[T] {
+	if b < 2 { //nolint:mnd
+		panic(fmt.Sprintf("Impossible checks passed to ValIsPowerOf:"+
+			" the base (%d) must be at least 2", b))
+	}
+
+	return func(v T) error {
+		n := v
+		for n > 1 && n%b == 0 {
+			n /= b
+		}
+
+		if n == 1 {
+			return nil
+		}
+
+		return newCheckError("ValIsPowerOf", v, map[string]any{"b": b},
+			"the value (%d) must be a power of %d", v, b)
+	}
+}
+
+// toBigInt returns the integer as a big.Int
+func toBigInt[T constraints.Integer](v T) *big.Int {
+	if v < 0 {
+		return big.NewInt(int64(v))
+	}
+
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+// ValIsPrime returns a function that will check that the value is a prime
+// number. The test is exact for all values of any integer type.
+func ValIsPrime[T constraints.Integer]() ValCk[T] {
+	return func(v T) error {
+		// ProbablyPrime is 100% accurate for values less than 2^64
+		if v > 1 && toBigInt(v).ProbablyPrime(0) {
+			return nil
+		}
+
+		return newCheckError("ValIsPrime", v, nil,
+			"the value (%d) must be a prime number", v)
+	}
+}
+
+// ValIsPerfectSquare returns a function that will check that the value is
+// the square of an integer
+func ValIsPerfectSquare[T constraints.Integer]() ValCk[T] {
+	return func(v T) error {
+		if v >= 0 {
+			bv := toBigInt(v)
+			r := new(big.Int).Sqrt(bv)
+
+			if r.Mul(r, r).Cmp(bv) == 0 {
+				return nil
+			}
+		}
+
+		return newCheckError("ValIsPerfectSquare", v, nil,
+			"the value (%d) must be a perfect square", v)
+	}
+}
+
+// bitsArg returns the integer as it should be shown in a message about its
+// bits. It is converted to a plain integer so that a flag type with a
+// String method is shown as a number.
+func bitsArg[T constraints.Integer](v T) any {
+	if v < 0 {
+		return int64(v)
+	}
+
+	return uint64(v)
+}
+
+// bitCount returns the number of bits set in the integer
+func bitCount[T constraints.Integer](v T) int {
+	n := 0
+
+	for ; v != 0; n++ {
+		v &= v - 1
+	}
+
+	return n
+}
+
+// ValHasAllBits returns a function that will check that the value has all
+// of the bits in the mask set. This can be used to check values of your own
+// flag types; FilePermHasAll is the equivalent for file permissions.
+func ValHasAllBits[T constraints.Integer](mask T) ValCk[T] {
+	return func(v T) error {
+		if v&mask == mask {
+			return nil
+		}
+
+		return newCheckError("ValHasAllBits", v,
+			map[string]any{"mask": mask},
+			"the value (%#x) must have all of the bits in %#x set",
+			bitsArg(v), bitsArg(mask))
+	}
+}
+
+// ValHasNoBits returns a function that will check that the value has none
+// of the bits in the mask set
+func ValHasNoBits[T constraints.Integer](mask T) ValCk[T] {
+	return func(v T) error {
+		if v&mask == 0 {
+			return nil
+		}
+
+		return newCheckError("ValHasNoBits", v,
+			map[string]any{"mask": mask},
+			"the value (%#x) must have none of the bits in %#x set",
+			bitsArg(v), bitsArg(mask))
+	}
+}
+
+// ValHasExactlyOneBit returns a function that will check that the value
+// has exactly one of the bits in the mask set. This is useful for checking
+// that just one of a set of mutually exclusive flags is given. It will
+// panic if the mask is zero.
+func ValHasExactlyOneBit[T constraints.Integer](mask T) ValCk[T] {
+	if mask == 0 {
+		panic("Impossible checks passed to ValHasExactlyOneBit:" +
+			" the mask is zero")
+	}
+
+	return func(v T) error {
+		n := bitCount(v & mask)
+		if n == 1 {
+			return nil
+		}
+
+		return newCheckError("ValHasExactlyOneBit", v,
+			map[string]any{"mask": mask},
+			"the value (%#x) must have exactly one of the bits in %#x set"+
+				" (it has %d)",
+			bitsArg(v), bitsArg(mask), n)
+	}
+}
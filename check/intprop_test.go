@@ -0,0 +1,265 @@
+package check_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestIntProps(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[int64]
+		val       int64
+	}{
+		{
+			ID:        testhelper.MkID("IsEven: -4"),
+			checkFunc: check.ValIsEven[int64](),
+			val:       -4,
+		},
+		{
+			ID:        testhelper.MkID("IsEven: 3"),
+			checkFunc: check.ValIsEven[int64](),
+			val:       3,
+			ExpErr:    testhelper.MkExpErr("the value (3) must be even"),
+		},
+		{
+			ID:        testhelper.MkID("IsOdd: -3"),
+			checkFunc: check.ValIsOdd[int64](),
+			val:       -3,
+		},
+		{
+			ID:        testhelper.MkID("IsOdd: 0"),
+			checkFunc: check.ValIsOdd[int64](),
+			val:       0,
+			ExpErr:    testhelper.MkExpErr("the value (0) must be odd"),
+		},
+		{
+			ID:        testhelper.MkID("IsPowerOf(2): 1024"),
+			checkFunc: check.ValIsPowerOf[int64](2),
+			val:       1024,
+		},
+		{
+			ID:        testhelper.MkID("IsPowerOf(3): 1"),
+			checkFunc: check.ValIsPowerOf[int64](3),
+			val:       1,
+		},
+		{
+			ID:        testhelper.MkID("IsPowerOf(3): 18"),
+			checkFunc: check.ValIsPowerOf[int64](3),
+			val:       18,
+			ExpErr: testhelper.MkExpErr(
+				"the value (18) must be a power of 3"),
+		},
+		{
+			ID:        testhelper.MkID("IsPowerOf(2): 0"),
+			checkFunc: check.ValIsPowerOf[int64](2),
+			val:       0,
+			ExpErr:    testhelper.MkExpErr("must be a power of 2"),
+		},
+		{
+			ID:        testhelper.MkID("IsPowerOf(2): -8"),
+			checkFunc: check.ValIsPowerOf[int64](2),
+			val:       -8,
+			ExpErr:    testhelper.MkExpErr("must be a power of 2"),
+		},
+		{
+			ID:        testhelper.MkID("IsPrime: 2"),
+			checkFunc: check.ValIsPrime[int64](),
+			val:       2,
+		},
+		{
+			ID:        testhelper.MkID("IsPrime: large prime"),
+			checkFunc: check.ValIsPrime[int64](),
+			val:       9223372036854775783,
+		},
+		{
+			ID:        testhelper.MkID("IsPrime: 1"),
+			checkFunc: check.ValIsPrime[int64](),
+			val:       1,
+			ExpErr: testhelper.MkExpErr(
+				"the value (1) must be a prime number"),
+		},
+		{
+			ID:        testhelper.MkID("IsPrime: -7"),
+			checkFunc: check.ValIsPrime[int64](),
+			val:       -7,
+			ExpErr:    testhelper.MkExpErr("must be a prime number"),
+		},
+		{
+			ID:        testhelper.MkID("IsPrime: 561 (a Carmichael number)"),
+			checkFunc: check.ValIsPrime[int64](),
+			val:       561,
+			ExpErr:    testhelper.MkExpErr("must be a prime number"),
+		},
+		{
+			ID:        testhelper.MkID("IsPerfectSquare: 0"),
+			checkFunc: check.ValIsPerfectSquare[int64](),
+			val:       0,
+		},
+		{
+			ID:        testhelper.MkID("IsPerfectSquare: 3037000499^2"),
+			checkFunc: check.ValIsPerfectSquare[int64](),
+			val:       3037000499 * 3037000499,
+		},
+		{
+			ID:        testhelper.MkID("IsPerfectSquare: 3037000499^2 - 1"),
+			checkFunc: check.ValIsPerfectSquare[int64](),
+			val:       3037000499*3037000499 - 1,
+			ExpErr:    testhelper.MkExpErr("must be a perfect square"),
+		},
+		{
+			ID:        testhelper.MkID("IsPerfectSquare: -4"),
+			checkFunc: check.ValIsPerfectSquare[int64](),
+			val:       -4,
+			ExpErr: testhelper.MkExpErr(
+				"the value (-4) must be a perfect square"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+// flags is a bitmask type with a String method
+type flags uint8
+
+const (
+	flagA flags = 1 << iota
+	flagB
+	flagC
+)
+
+// String returns the name of a single flag
+func (f flags) String() string {
+	switch f {
+	case flagA:
+		return "A"
+	case flagB:
+		return "B"
+	case flagC:
+		return "C"
+	}
+
+	return "flags"
+}
+
+func TestBits(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[flags]
+		val       flags
+	}{
+		{
+			ID:        testhelper.MkID("HasAllBits: A|B|C has A|C"),
+			checkFunc: check.ValHasAllBits(flagA | flagC),
+			val:       flagA | flagB | flagC,
+		},
+		{
+			ID:        testhelper.MkID("HasAllBits: A|B lacks C"),
+			checkFunc: check.ValHasAllBits(flagA | flagC),
+			val:       flagA | flagB,
+			ExpErr: testhelper.MkExpErr(
+				"the value (0x3) must have all of the bits in 0x5 set"),
+		},
+		{
+			ID:        testhelper.MkID("HasNoBits: B has none of A|C"),
+			checkFunc: check.ValHasNoBits(flagA | flagC),
+			val:       flagB,
+		},
+		{
+			ID:        testhelper.MkID("HasNoBits: C"),
+			checkFunc: check.ValHasNoBits(flagA | flagC),
+			val:       flagC,
+			ExpErr: testhelper.MkExpErr(
+				"the value (0x4) must have none of the bits in 0x5 set"),
+		},
+		{
+			ID:        testhelper.MkID("HasExactlyOneBit: B|C has one of A|B"),
+			checkFunc: check.ValHasExactlyOneBit(flagA | flagB),
+			val:       flagB | flagC,
+		},
+		{
+			ID:        testhelper.MkID("HasExactlyOneBit: C has none of A|B"),
+			checkFunc: check.ValHasExactlyOneBit(flagA | flagB),
+			val:       flagC,
+			ExpErr: testhelper.MkExpErr(
+				"the value (0x4) must have exactly one of the bits in 0x3" +
+					" set (it has 0)"),
+		},
+		{
+			ID:        testhelper.MkID("HasExactlyOneBit: A|B"),
+			checkFunc: check.ValHasExactlyOneBit(flagA | flagB),
+			val:       flagA | flagB,
+			ExpErr:    testhelper.MkExpErr("(it has 2)"),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestBitsSigned(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[int8]
+		val       int8
+	}{
+		{
+			ID:        testhelper.MkID("HasExactlyOneBit: sign bit"),
+			checkFunc: check.ValHasExactlyOneBit[int8](math.MinInt8),
+			val:       math.MinInt8,
+		},
+		{
+			ID:        testhelper.MkID("HasAllBits: -1 has all"),
+			checkFunc: check.ValHasAllBits[int8](math.MinInt8 | 1),
+			val:       -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestIntPropsPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("IsPowerOf: base 1"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValIsPowerOf:" +
+					" the base (1) must be at least 2"),
+			f: func() { check.ValIsPowerOf(1) },
+		},
+		{
+			ID: testhelper.MkID("HasExactlyOneBit: zero mask"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValHasExactlyOneBit:" +
+					" the mask is zero"),
+			f: func() { check.ValHasExactlyOneBit(0) },
+		},
+		{
+			ID: testhelper.MkID("IsPowerOf: good"),
+			f:  func() { check.ValIsPowerOf(uint8(2)) },
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
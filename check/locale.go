@@ -0,0 +1,276 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nickwells/english.mod/english"
+)
+
+// Catalogue holds the translations of the check error messages into a
+// language. The messages are identified by their message ID, which is the
+// English format string used to construct them, as given by the MsgID
+// method of CheckError. For instance, the message ID of the error returned
+// by ValGT is
+//
+//	"the value (%v) must be greater than %v"
+//
+// The translated format string is applied to the same arguments as the
+// English one so you can use explicit argument indexes (such as %[2]v) if
+// the words need to be in a different order. The %w verb is treated as %v.
+// Any message without a translation is shown in English, as are the
+// argument values other than those listed below.
+//
+// Wrapped check errors, lists, ordinal numbers and the names of days of the
+// week and months of the year are translated as well. Lists are joined with
+// the JoinList func which is given the translated items and the English
+// conjunction ("and" or "or"). Ordinals are formed with the Ordinal func,
+// so 1 becomes "1st" in English. The names of days and months are
+// translated using the Msgs map with the English name as the key. If
+// JoinList or Ordinal is nil the English form is used.
+type Catalogue struct {
+	Lang     string
+	Msgs     map[string]string
+	JoinList func(items []string, conj string) string
+	Ordinal  func(n int) string
+}
+
+// englishCat is the built-in English catalogue; the messages are given by
+// their IDs so it needs no translations.
+var englishCat = &Catalogue{Lang: "en"}
+
+var catalogues = struct {
+	sync.RWMutex
+	m map[string]*Catalogue
+}{
+	m: map[string]*Catalogue{englishCat.Lang: englishCat},
+}
+
+// RegisterCatalogue registers the catalogue for its language, replacing any
+// catalogue previously registered for that language. It will panic if the
+// catalogue is nil or has no language.
+func RegisterCatalogue(c *Catalogue) {
+	if c == nil {
+		panic("RegisterCatalogue: a nil Catalogue has been given")
+	}
+
+	if c.Lang == "" {
+		panic("RegisterCatalogue: the Catalogue has no language")
+	}
+
+	catalogues.Lock()
+	defer catalogues.Unlock()
+
+	catalogues.m[c.Lang] = c
+}
+
+// findCatalogue returns the catalogue for the language. If there is no
+// catalogue for a language with a region (such as "fr-CA") then that for
+// the base language ("fr") is used. If there is no catalogue at all the
+// English catalogue is returned.
+func findCatalogue(lang string) *Catalogue {
+	catalogues.RLock()
+	defer catalogues.RUnlock()
+
+	if c, ok := catalogues.m[lang]; ok {
+		return c
+	}
+
+	if base, _, ok := strings.Cut(strings.ReplaceAll(lang, "_", "-"), "-"); ok {
+		if c, ok := catalogues.m[base]; ok {
+			return c
+		}
+	}
+
+	return englishCat
+}
+
+// LocalMsg returns the message for the error in the given language. If the
+// error is not a CheckError its Error method is used. It returns the empty
+// string if the error is nil.
+func LocalMsg(err error, lang string) string {
+	if err == nil {
+		return ""
+	}
+
+	return findCatalogue(lang).errMsg(err)
+}
+
+// langKey is the context key for the language
+type langKey struct{}
+
+// WithLang returns a copy of the context which records the language to be
+// used by LocalMsgCtx
+func WithLang(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+// LocalMsgCtx returns the message for the error in the language recorded in
+// the context by WithLang (English if none has been recorded). See LocalMsg
+// for details.
+func LocalMsgCtx(ctx context.Context, err error) string {
+	lang, _ := ctx.Value(langKey{}).(string)
+
+	return LocalMsg(err, lang)
+}
+
+// errMsg returns the message for the error translated using the catalogue
+func (c *Catalogue) errMsg(err error) string {
+	ce, ok := err.(*CheckError) //nolint:errorlint
+	if !ok {
+		return err.Error()
+	}
+
+	if ce.text != nil {
+		return ce.text.localise(c)
+	}
+
+	if len(ce.Errs) == 0 {
+		return ce.Msg
+	}
+
+	msgs := make([]string, 0, len(ce.Errs))
+	for _, e := range ce.Errs {
+		msgs = append(msgs, c.errMsg(e))
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// translate returns the translation of the message ID or, if there is none,
+// the message ID itself
+func (c *Catalogue) translate(id string) string {
+	if t, ok := c.Msgs[id]; ok {
+		return t
+	}
+
+	return id
+}
+
+// joinList returns the items joined into a list with the conjunction
+func (c *Catalogue) joinList(items []string, conj string) string {
+	if c.JoinList != nil {
+		return c.JoinList(items, conj)
+	}
+
+	return english.Join(items, ", ", " "+conj+" ")
+}
+
+// ordinal returns the ordinal form of the number
+func (c *Catalogue) ordinal(n int) string {
+	if c.Ordinal != nil {
+		return c.Ordinal(n)
+	}
+
+	return fmt.Sprintf("%d%s", n, english.OrdinalSuffix(n))
+}
+
+// localiseArg returns the message argument translated using the catalogue
+func (c *Catalogue) localiseArg(a any) any {
+	switch a := a.(type) {
+	case localisable:
+		return a.localise(c)
+	case error:
+		return c.errMsg(a)
+	case time.Weekday, time.Month:
+		return c.translate(fmt.Sprint(a))
+	}
+
+	return a
+}
+
+// localisable is the interface satisfied by message parts which can be
+// translated
+type localisable interface {
+	localise(c *Catalogue) string
+}
+
+// wrapVerb matches the %w verb (with an optional argument index)
+var wrapVerb = regexp.MustCompile(`%(\[[0-9]+\])?w`)
+
+// msg is a message (or part of one) to be formatted. The ID is the English
+// format string.
+type msg struct {
+	id   string
+	args []any
+}
+
+// mkMsg returns a msg with the given ID and arguments
+func mkMsg(id string, args ...any) msg {
+	return msg{id: id, args: args}
+}
+
+// localise returns the message translated using the catalogue
+func (m msg) localise(c *Catalogue) string {
+	args := make([]any, 0, len(m.args))
+	for _, a := range m.args {
+		args = append(args, c.localiseArg(a))
+	}
+
+	return fmt.Sprintf(wrapVerb.ReplaceAllString(c.translate(m.id), "%${1}v"),
+		args...)
+}
+
+// String returns the message in English
+func (m msg) String() string {
+	return m.localise(englishCat)
+}
+
+// msgSeq is a message made up of a sequence of messages
+type msgSeq []msg
+
+// localise returns the messages translated using the catalogue and
+// concatenated
+func (ms msgSeq) localise(c *Catalogue) string {
+	var s strings.Builder
+
+	for _, m := range ms {
+		s.WriteString(m.localise(c))
+	}
+
+	return s.String()
+}
+
+// String returns the message in English
+func (ms msgSeq) String() string {
+	return ms.localise(englishCat)
+}
+
+// list is a list of items to be joined with the conjunction ("and" or
+// "or")
+type list struct {
+	items []any
+	conj  string
+}
+
+// localise returns the list with the items translated using the catalogue
+func (l list) localise(c *Catalogue) string {
+	items := make([]string, 0, len(l.items))
+	for _, item := range l.items {
+		items = append(items, fmt.Sprint(c.localiseArg(item)))
+	}
+
+	return c.joinList(items, l.conj)
+}
+
+// String returns the list in English
+func (l list) String() string {
+	return l.localise(englishCat)
+}
+
+// ordinal is an ordinal number: 1st, 2nd, 3rd etc.
+type ordinal int
+
+// localise returns the ordinal number in the language of the catalogue
+func (o ordinal) localise(c *Catalogue) string {
+	return c.ordinal(int(o))
+}
+
+// String returns the ordinal number in English
+func (o ordinal) String() string {
+	return o.localise(englishCat)
+}
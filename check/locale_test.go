@@ -0,0 +1,211 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func init() {
+	check.RegisterCatalogue(&check.Catalogue{
+		Lang: "fr",
+		Msgs: map[string]string{
+			"the value (%v) must be greater than %v": "la valeur (%v)" +
+				" doit être supérieure à %v",
+			"the value (%v) must be less than %v": "la valeur (%v)" +
+				" doit être inférieure à %v",
+			"either [%w]": "soit [%w]",
+			" or [%w]":    " soit [%w]",
+			"list entry: %d (%v) does not pass the test: %w": "l'élément" +
+				" %[1]d de la liste est incorrect (%[2]v): %[3]w",
+			"the day of the week (%s) must be a %s": "le jour de la" +
+				" semaine (%s) doit être un %s",
+			"the day is not the %s of the month (it is the %s)": "le jour" +
+				" n'est pas le %s du mois (c'est le %s)",
+			"%s %s":    "%[2]s %[1]s",
+			"Monday":   "lundi",
+			"Tuesday":  "mardi",
+			"Saturday": "samedi",
+			"Sunday":   "dimanche",
+		},
+		JoinList: func(items []string, conj string) string {
+			if conj == "or" {
+				conj = "ou"
+			}
+
+			return strings.Join(items, " "+conj+" ")
+		},
+		Ordinal: func(n int) string {
+			if n == 1 {
+				return "1er"
+			}
+
+			return strconv.Itoa(n) + "e"
+		},
+	})
+}
+
+func TestLocalMsg(t *testing.T) {
+	testTime := time.Date(2018, time.December, 18, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		testhelper.ID
+		err    error
+		lang   string
+		expMsg string
+	}{
+		{
+			ID:     testhelper.MkID("nil error"),
+			lang:   "fr",
+			expMsg: "",
+		},
+		{
+			ID:     testhelper.MkID("not a CheckError"),
+			err:    errors.New("whoops"),
+			lang:   "fr",
+			expMsg: "whoops",
+		},
+		{
+			ID:     testhelper.MkID("ValGT - English"),
+			err:    check.ValGT(5)(1),
+			lang:   "en",
+			expMsg: "the value (1) must be greater than 5",
+		},
+		{
+			ID:     testhelper.MkID("ValGT - unknown language"),
+			err:    check.ValGT(5)(1),
+			lang:   "xx",
+			expMsg: "the value (1) must be greater than 5",
+		},
+		{
+			ID:     testhelper.MkID("ValGT - French"),
+			err:    check.ValGT(5)(1),
+			lang:   "fr",
+			expMsg: "la valeur (1) doit être supérieure à 5",
+		},
+		{
+			ID:     testhelper.MkID("ValGT - French (Canada)"),
+			err:    check.ValGT(5)(1),
+			lang:   "fr-CA",
+			expMsg: "la valeur (1) doit être supérieure à 5",
+		},
+		{
+			ID:     testhelper.MkID("ValGE - no translation"),
+			err:    check.ValGE(5)(1),
+			lang:   "fr",
+			expMsg: "the value (1) must be greater than or equal to 5",
+		},
+		{
+			ID: testhelper.MkID("SliceAll(Or) - reordered arguments"),
+			err: check.SliceAll[[]int](
+				check.Or(check.ValGT(5), check.ValLT(0)))([]int{9, 1}),
+			lang: "fr",
+			expMsg: "l'élément 1 de la liste est incorrect (1):" +
+				" soit [la valeur (1) doit être supérieure à 5]" +
+				" soit [la valeur (1) doit être inférieure à 0]",
+		},
+		{
+			ID: testhelper.MkID("SliceAllErrs - joined errors"),
+			err: check.SliceAllErrs[[]int](check.ValGT(5))(
+				[]int{1, 9, 2}),
+			lang: "fr",
+			expMsg: "l'élément 0 de la liste est incorrect (1):" +
+				" la valeur (1) doit être supérieure à 5\n" +
+				"l'élément 2 de la liste est incorrect (2):" +
+				" la valeur (2) doit être supérieure à 5",
+		},
+		{
+			ID: testhelper.MkID("TimeIsOnDOW - list of days"),
+			err: check.TimeIsOnDOW(time.Saturday, time.Sunday, time.Monday)(
+				testTime),
+			lang: "fr",
+			expMsg: "le jour de la semaine (mardi) doit être un" +
+				" samedi ou dimanche ou lundi",
+		},
+		{
+			ID:   testhelper.MkID("TimeIsNthWeekdayOfMonth - ordinals"),
+			err:  check.TimeIsNthWeekdayOfMonth(1, time.Tuesday)(testTime),
+			lang: "fr",
+			expMsg: "le jour n'est pas le mardi 1er du mois" +
+				" (c'est le 3e)",
+		},
+		{
+			ID:   testhelper.MkID("TimeIsNthWeekdayOfMonth - English"),
+			err:  check.TimeIsNthWeekdayOfMonth(1, time.Tuesday)(testTime),
+			lang: "en",
+			expMsg: "the day is not the 1st Tuesday of the month" +
+				" (it is the 3rd)",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "message",
+			check.LocalMsg(tc.err, tc.lang), tc.expMsg)
+
+		if tc.lang == "en" {
+			testhelper.DiffString(t, tc.IDStr(), "English message",
+				tc.err.Error(), tc.expMsg)
+		}
+	}
+}
+
+func TestLocalMsgCtx(t *testing.T) {
+	err := check.ValGT(5)(1)
+
+	testhelper.DiffString(t, "no language", "message",
+		check.LocalMsgCtx(context.Background(), err),
+		"the value (1) must be greater than 5")
+	testhelper.DiffString(t, "French", "message",
+		check.LocalMsgCtx(check.WithLang(context.Background(), "fr"), err),
+		"la valeur (1) doit être supérieure à 5")
+}
+
+func TestMsgID(t *testing.T) {
+	var ce *check.CheckError
+
+	if !errors.As(check.ValGT(5)(1), &ce) {
+		t.Fatal("ValGT should return a *check.CheckError")
+	}
+
+	testhelper.DiffString(t, "ValGT", "MsgID", ce.MsgID(),
+		"the value (%v) must be greater than %v")
+	testhelper.DiffString(t, "ValGT", "LocalMsg", ce.LocalMsg("fr"),
+		"la valeur (1) doit être supérieure à 5")
+
+	if !errors.As(check.Or(check.ValGT(5), check.ValLT(0))(1), &ce) {
+		t.Fatal("Or should return a *check.CheckError")
+	}
+
+	testhelper.DiffString(t, "Or", "MsgID", ce.MsgID(), "")
+}
+
+func TestRegisterCataloguePanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		c *check.Catalogue
+	}{
+		{
+			ID:       testhelper.MkID("nil"),
+			ExpPanic: testhelper.MkExpPanic("a nil Catalogue"),
+		},
+		{
+			ID:       testhelper.MkID("no language"),
+			ExpPanic: testhelper.MkExpPanic("the Catalogue has no language"),
+			c:        &check.Catalogue{},
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(func() {
+			check.RegisterCatalogue(tc.c)
+		})
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
@@ -0,0 +1,94 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// MsgTmplData is the data available to the template given to WithMsg. The
+// Params are those of the CheckError returned by the check (if any) so, for
+// instance, given a check constructed by ValIsAMultiple(5) the template
+// "{{.Params.d}}" would give "5".
+type MsgTmplData[T any] struct {
+	Value  T
+	Err    error
+	Params map[string]any
+}
+
+// WithMsg returns a function that applies the check and, if it fails,
+// returns an error with the message given by the template. The template is
+// a text/template and is executed with a MsgTmplData. For instance,
+//
+//	check.WithMsg(check.ValIsAMultiple(5),
+//		"--retries must be a multiple of {{.Params.d}} (got {{.Value}})")
+//
+// would give the message "--retries must be a multiple of 5 (got 7)" for a
+// value of 7. The template can decorate rather than replace the message by
+// including the error ("{{.Err}}").
+//
+// The error returned wraps the error from the check and has the same
+// severity. If the template cannot be executed the message from the check
+// is used. It will panic if the template cannot be parsed.
+func WithMsg[T any](cf ValCk[T], tmpl string) ValCk[T] {
+	t, err := template.New("WithMsg").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		panic(fmt.Sprintf("Impossible template passed to WithMsg: %s", err))
+	}
+
+	return func(v T) error {
+		err := cf(v)
+		if err == nil {
+			return nil
+		}
+
+		data := MsgTmplData[T]{Value: v, Err: err}
+
+		var ce *CheckError
+		if errors.As(err, &ce) {
+			data.Params = ce.Params
+		}
+
+		var s strings.Builder
+
+		text := fixedText(err.Error())
+		if t.Execute(&s, data) == nil {
+			text = fixedText(s.String())
+		}
+
+		return (&CheckError{
+			CheckID: "WithMsg",
+			Value:   v,
+			Params:  map[string]any{"template": tmpl},
+			Msg:     string(text),
+			Errs:    []error{err},
+			text:    text,
+		}).sevOf(err)
+	}
+}
+
+// Named returns a function that applies the check and, if it fails,
+// returns an error whose message is the name followed by the message from
+// the check. The name should say what is being checked, for instance the
+// name of a parameter or a field. The error returned wraps the error from
+// the check and has the same severity.
+func Named[T any](name string, cf ValCk[T]) ValCk[T] {
+	return func(v T) error {
+		err := cf(v)
+		if err == nil {
+			return nil
+		}
+
+		return newCheckError("Named", v, map[string]any{"name": name},
+			"%s: %w", name, err).sevOf(err)
+	}
+}
+
+// fixedText is a message which is not translated
+type fixedText string
+
+// localise returns the text unchanged
+func (ft fixedText) localise(_ *Catalogue) string {
+	return string(ft)
+}
@@ -0,0 +1,103 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestWithMsg(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc  check.ValCk[int]
+		val        int
+		expWarning bool
+	}{
+		{
+			ID: testhelper.MkID("pass"),
+			checkFunc: check.WithMsg(check.ValIsAMultiple(5),
+				"--retries must be a multiple of {{.Params.d}}"),
+			val: 10,
+		},
+		{
+			ID: testhelper.MkID("replace"),
+			ExpErr: testhelper.MkExpErr(
+				"--retries must be a multiple of 5 (got 7)"),
+			checkFunc: check.WithMsg(check.ValIsAMultiple(5),
+				"--retries must be a multiple of {{.Params.d}}"+
+					" (got {{.Value}})"),
+			val: 7,
+		},
+		{
+			ID: testhelper.MkID("decorate"),
+			ExpErr: testhelper.MkExpErr(
+				"bad --retries: the value (7) must be a multiple of 5"),
+			checkFunc: check.WithMsg(check.ValIsAMultiple(5),
+				"bad --retries: {{.Err}}"),
+			val: 7,
+		},
+		{
+			ID: testhelper.MkID("missing parameter"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be a multiple of 5"),
+			checkFunc: check.WithMsg(check.ValIsAMultiple(5),
+				"{{.Params.nonesuch}}"),
+			val: 7,
+		},
+		{
+			ID:     testhelper.MkID("warning"),
+			ExpErr: testhelper.MkExpErr("7 is not advised"),
+			checkFunc: check.WithMsg(check.Warning(check.ValLT(5)),
+				"{{.Value}} is not advised"),
+			val:        7,
+			expWarning: true,
+		},
+		{
+			ID:     testhelper.MkID("Named"),
+			ExpErr: testhelper.MkExpErr("--retries: the value (7)"),
+			checkFunc: check.Named("--retries",
+				check.ValIsAMultiple(5)),
+			val: 7,
+		},
+		{
+			ID:        testhelper.MkID("Named - pass"),
+			checkFunc: check.Named("--retries", check.ValIsAMultiple(5)),
+			val:       5,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+		testhelper.DiffBool(t, tc.IDStr(), "IsWarning",
+			check.IsWarning(err), tc.expWarning)
+	}
+}
+
+func TestWithMsgWraps(t *testing.T) {
+	baseErr := errors.New("base")
+	err := check.WithMsg(func(_ int) error { return baseErr }, "bad")(1)
+
+	if !errors.Is(err, baseErr) {
+		t.Errorf("the error should wrap the check error")
+	}
+}
+
+func TestWithMsgPanic(t *testing.T) {
+	tc := struct {
+		testhelper.ID
+		testhelper.ExpPanic
+	}{
+		ID: testhelper.MkID("bad template"),
+		ExpPanic: testhelper.MkExpPanic(
+			"Impossible template passed to WithMsg"),
+	}
+
+	panicked, panicVal := testhelper.PanicSafe(func() {
+		check.WithMsg(check.ValOK[int], "{{.Value")
+	})
+	testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+}
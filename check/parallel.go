@@ -0,0 +1,185 @@
+package check
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nickwells/check.mod/v2/check/internal/keyorder"
+)
+
+// parallelErrs applies the check func to each of the n items, identified
+// by their index, using up to the given number of goroutines. If workers is
+// less than 1, runtime.GOMAXPROCS(0) goroutines are used. It returns a
+// slice holding the error (if any) for each item.
+//
+// If firstOnly is true then items after the lowest-indexed failure found so
+// far may be skipped; the error for the lowest-indexed failing item will
+// always be present. Warnings do not count as failures for this purpose.
+func parallelErrs(n, workers int, firstOnly bool, cf func(i int) error,
+) []error {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	workers = min(workers, n)
+
+	errs := make([]error, n)
+
+	var (
+		next     atomic.Int64
+		firstBad atomic.Int64
+		wg       sync.WaitGroup
+	)
+
+	firstBad.Store(math.MaxInt64)
+
+	for range workers {
+		wg.Go(func() {
+			for {
+				i := next.Add(1) - 1
+				if i >= int64(n) {
+					return
+				}
+
+				if firstOnly && i > firstBad.Load() {
+					continue
+				}
+
+				errs[i] = cf(int(i))
+				if errs[i] == nil || !firstOnly || IsWarning(errs[i]) {
+					continue
+				}
+
+				for {
+					bad := firstBad.Load()
+					if i >= bad || firstBad.CompareAndSwap(bad, i) {
+						break
+					}
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// SliceAllParallel returns a function that will apply the supplied check
+// func to each of the elements of the slice using up to the given number of
+// goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) goroutines
+// are used. The error returned is the same as SliceAll would return: that
+// for the failing entry with the lowest index.
+//
+// The check func must be safe to call concurrently.
+//
+// It returns nil if all the entries pass the check.
+func SliceAllParallel[S ~[]E, E any](workers int, cf ValCk[E]) ValCk[S] {
+	return func(v S) error {
+		errs := parallelErrs(len(v), workers, true,
+			func(i int) error { return cf(v[i]) })
+
+		var entryErrs []*CheckError
+
+		for i, err := range errs {
+			if err != nil {
+				entryErrs = append(entryErrs,
+					sliceEntryErr("SliceAll", v, i, v[i], err))
+			}
+		}
+
+		return firstErr("SliceAll", v, entryErrs)
+	}
+}
+
+// SliceAllErrsParallel returns a function that will apply the supplied
+// check func to each of the elements of the slice using up to the given
+// number of goroutines. If workers is less than 1, runtime.GOMAXPROCS(0)
+// goroutines are used. The error returned is the same as SliceAllErrs would
+// return: it wraps an error for each failing entry, in index order.
+//
+// The check func must be safe to call concurrently.
+//
+// It returns nil if all the entries pass the check.
+func SliceAllErrsParallel[S ~[]E, E any](workers int, cf ValCk[E]) ValCk[S] {
+	return func(v S) error {
+		errs := parallelErrs(len(v), workers, false,
+			func(i int) error { return cf(v[i]) })
+
+		var entryErrs []*CheckError
+
+		for i, err := range errs {
+			if err != nil {
+				entryErrs = append(entryErrs,
+					sliceEntryErr("SliceAllErrs", v, i, v[i], err))
+			}
+		}
+
+		return allErrs("SliceAllErrs", v, entryErrs)
+	}
+}
+
+// MapValAllParallel returns a function that will apply the supplied check
+// func to each value in the map using up to the given number of
+// goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) goroutines
+// are used. The error returned is the same as MapValAll would return for
+// the first failing value except that, unlike MapValAll, the values are
+// taken in key order (as for MapKeyAllErrs) so the error is reproducible.
+//
+// The check func must be safe to call concurrently.
+//
+// It returns nil if all the values pass the check.
+func MapValAllParallel[M ~map[K]V, K comparable, V any](workers int,
+	cf ValCk[V],
+) ValCk[M] {
+	return func(m M) error {
+		entries := keyorder.Entries(m)
+		errs := parallelErrs(len(entries), workers, true,
+			func(i int) error { return cf(entries[i].Val) })
+
+		var valErrs []*CheckError
+
+		for i, err := range errs {
+			if err != nil {
+				e := entries[i]
+				valErrs = append(valErrs,
+					mapValErr("MapValAll", m, e.Key, e.Val, err))
+			}
+		}
+
+		return firstErr("MapValAll", m, valErrs)
+	}
+}
+
+// MapValAllErrsParallel returns a function that will apply the supplied
+// check func to each value in the map using up to the given number of
+// goroutines. If workers is less than 1, runtime.GOMAXPROCS(0) goroutines
+// are used. The error returned is the same as MapValAllErrs would return:
+// it wraps an error for each failing value, in key order.
+//
+// The check func must be safe to call concurrently.
+//
+// It returns nil if all the values pass the check.
+func MapValAllErrsParallel[M ~map[K]V, K comparable, V any](workers int,
+	cf ValCk[V],
+) ValCk[M] {
+	return func(m M) error {
+		entries := keyorder.Entries(m)
+		errs := parallelErrs(len(entries), workers, false,
+			func(i int) error { return cf(entries[i].Val) })
+
+		var valErrs []*CheckError
+
+		for i, err := range errs {
+			if err != nil {
+				e := entries[i]
+				valErrs = append(valErrs,
+					mapValErr("MapValAllErrs", m, e.Key, e.Val, err))
+			}
+		}
+
+		return allErrs("MapValAllErrs", m, valErrs)
+	}
+}
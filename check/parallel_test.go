@@ -0,0 +1,100 @@
+package check_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// errStr returns the error text or "<nil>"
+func errStr(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	return err.Error()
+}
+
+func TestSliceAllParallel(t *testing.T) {
+	cf := check.ValLT(50)
+
+	testCases := []struct {
+		testhelper.ID
+		val []int
+	}{
+		{
+			ID:  testhelper.MkID("empty"),
+			val: []int{},
+		},
+		{
+			ID:  testhelper.MkID("all good"),
+			val: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			ID:  testhelper.MkID("several bad"),
+			val: []int{1, 2, 3, 99, 5, 60, 7, 8, 51, 1, 1, 1, 1, 100},
+		},
+		{
+			ID:  testhelper.MkID("last bad"),
+			val: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 77},
+		},
+	}
+
+	for _, tc := range testCases {
+		expFirst := errStr(check.SliceAll[[]int](cf)(tc.val))
+		expAll := errStr(check.SliceAllErrs[[]int](cf)(tc.val))
+
+		for _, workers := range []int{-1, 0, 1, 2, 3, 100} {
+			id := fmt.Sprintf("%s - workers: %d", tc.IDStr(), workers)
+			testhelper.DiffString(t, id, "first error",
+				errStr(check.SliceAllParallel[[]int](workers, cf)(tc.val)),
+				expFirst)
+			testhelper.DiffString(t, id, "all errors",
+				errStr(check.SliceAllErrsParallel[[]int](workers, cf)(tc.val)),
+				expAll)
+		}
+	}
+}
+
+func TestMapValAllParallel(t *testing.T) {
+	cf := check.ValLT(50)
+	m := map[string]int{}
+
+	for i := range 40 {
+		m[fmt.Sprintf("k%02d", i)] = i * 3
+	}
+
+	expAll := errStr(check.MapValAllErrs[map[string]int](cf)(m))
+
+	for _, workers := range []int{0, 1, 4, 100} {
+		id := fmt.Sprintf("workers: %d", workers)
+		testhelper.DiffString(t, id, "first error",
+			errStr(check.MapValAllParallel[map[string]int](workers, cf)(m)),
+			"map entry[k17], bad value: the value (51) must be less than 50")
+		testhelper.DiffString(t, id, "all errors",
+			errStr(check.MapValAllErrsParallel[map[string]int](workers, cf)(m)),
+			expAll)
+	}
+
+	testhelper.DiffString(t, "good", "error",
+		errStr(check.MapValAllParallel[map[string]int](2, check.ValLT(200))(m)),
+		"<nil>")
+}
+
+func TestSliceAllParallelCallsAll(t *testing.T) {
+	var calls atomic.Int64
+
+	cf := func(v int) error {
+		calls.Add(1)
+		return check.ValGE(0)(v)
+	}
+
+	vals := make([]int, 1000)
+
+	err := check.SliceAllErrsParallel[[]int](8, cf)(vals)
+	testhelper.DiffErr(t, "all good", "error", err, nil)
+	testhelper.DiffInt(t, "all good", "calls", calls.Load(), int64(len(vals)))
+}
@@ -0,0 +1,104 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PathElemKind records the kind of a PathElem
+type PathElemKind int
+
+// These are the different kinds of PathElem
+const (
+	PathIndex PathElemKind = iota // an index into a slice
+	PathKey                       // a map key
+	PathField                     // a named field or attribute
+)
+
+// PathElem is a single step in a Path. Only the member corresponding to the
+// Kind is set.
+type PathElem struct {
+	Kind  PathElemKind
+	Index int
+	Key   any
+	Field string
+}
+
+// IndexElem returns a PathElem for the given slice index
+func IndexElem(i int) PathElem {
+	return PathElem{Kind: PathIndex, Index: i}
+}
+
+// KeyElem returns a PathElem for the given map key
+func KeyElem(k any) PathElem {
+	return PathElem{Kind: PathKey, Key: k}
+}
+
+// FieldElem returns a PathElem for the named field
+func FieldElem(name string) PathElem {
+	return PathElem{Kind: PathField, Field: name}
+}
+
+// String returns a string representation of the PathElem. An index is shown
+// as [3], a key as ["foo"] (string keys are quoted) and a field as .Name
+func (pe PathElem) String() string {
+	switch pe.Kind {
+	case PathIndex:
+		return fmt.Sprintf("[%d]", pe.Index)
+	case PathKey:
+		if s, ok := pe.Key.(string); ok {
+			return fmt.Sprintf("[%q]", s)
+		}
+
+		return fmt.Sprintf("[%v]", pe.Key)
+	case PathField:
+		return "." + pe.Field
+	}
+
+	return fmt.Sprintf("<bad PathElemKind: %d>", pe.Kind)
+}
+
+// Path records the location of the part of a value that failed a check. For
+// instance, given a slice of maps, the path to the value for key "foo" in the
+// fourth map would be [3]["foo"].
+type Path []PathElem
+
+// String returns a string representation of the Path
+func (p Path) String() string {
+	var s strings.Builder
+
+	for _, pe := range p {
+		s.WriteString(pe.String())
+	}
+
+	return s.String()
+}
+
+// ErrPath returns the Path to the part of the checked value that caused the
+// error. It is built from the locations recorded in the chain of CheckErrors
+// wrapping the error returned by the innermost failing check. It will be
+// empty if the failure was not in some part of the value or if the error is
+// not a CheckError. Note that the path stops at any error which wraps
+// multiple errors (as returned by Or or SliceAllErrs, for instance); you
+// will need to find the paths of each of the wrapped errors separately.
+func ErrPath(err error) Path {
+	var p Path
+
+	for err != nil {
+		var ce *CheckError
+		if !errors.As(err, &ce) {
+			break
+		}
+
+		p = append(p, ce.Loc...)
+
+		if len(ce.Errs) != 1 {
+			break
+		}
+
+		err = ce.Errs[0]
+	}
+
+	return p
+}
@@ -0,0 +1,110 @@
+package check_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestPathString(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		p      check.Path
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("empty"),
+			p:      check.Path{},
+			expStr: "",
+		},
+		{
+			ID: testhelper.MkID("index, string key, int key, field"),
+			p: check.Path{
+				check.IndexElem(3),
+				check.KeyElem("foo"),
+				check.KeyElem(42),
+				check.FieldElem("Size"),
+			},
+			expStr: `[3]["foo"][42].Size`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "path", tc.p.String(), tc.expStr)
+	}
+}
+
+func TestErrPath(t *testing.T) {
+	fi, err := os.Stat("testdata/IsAFile")
+	if err != nil {
+		t.Fatal("pre-test setup: cannot stat testdata/IsAFile: ", err)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		err     error
+		expPath string
+	}{
+		{
+			ID:      testhelper.MkID("no error"),
+			err:     nil,
+			expPath: "",
+		},
+		{
+			ID:      testhelper.MkID("not a CheckError"),
+			err:     errors.New("whoops"),
+			expPath: "",
+		},
+		{
+			ID:      testhelper.MkID("simple check"),
+			err:     check.ValGT(5)(1),
+			expPath: "",
+		},
+		{
+			ID: testhelper.MkID("SliceAll of MapValAll"),
+			err: check.SliceAll[[]map[string]string](
+				check.MapValAll[map[string]string](
+					check.StringLength[string](check.ValLT(3))))(
+				[]map[string]string{
+					{"a": "x"},
+					{"b": "y"},
+					{"c": "z"},
+					{"foo": "too long", "bar": "ok"},
+				}),
+			expPath: `[3]["foo"]`,
+		},
+		{
+			ID: testhelper.MkID("MapKeyAll"),
+			err: check.MapKeyAll[map[int]bool](check.ValLT(10))(
+				map[int]bool{1: true, 12: false}),
+			expPath: `[12]`,
+		},
+		{
+			ID: testhelper.MkID("SliceByPos - beyond the checks"),
+			err: check.SliceByPos[[]int](check.ValOK[int], check.ValLT(5))(
+				[]int{9, 1, 2, 7}),
+			expPath: `[3]`,
+		},
+		{
+			ID: testhelper.MkID("SliceAll of FileInfoSize"),
+			err: check.SliceAll[[]os.FileInfo](
+				check.FileInfoSize(check.ValGT[int64](1e9)))(
+				[]os.FileInfo{fi}),
+			expPath: `[0].Size`,
+		},
+		{
+			ID: testhelper.MkID("SliceAllErrs stops at the multi-error"),
+			err: check.SliceAllErrs[[]int](check.ValLT(5))(
+				[]int{9, 1, 7}),
+			expPath: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "path",
+			check.ErrPath(tc.err).String(), tc.expPath)
+	}
+}
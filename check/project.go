@@ -0,0 +1,60 @@
+package check
+
+// Project returns a function that will derive a value from the value being
+// checked using the projection func and then apply the supplied check func
+// to the derived value. The description should describe the derived value
+// (for instance, "length of the list") and is used in the error message
+// which will be of the form:
+//
+//	the <desc> (<derived value>) is incorrect: <error from check func>
+func Project[T, U any](f func(T) U, desc string, cf ValCk[U]) ValCk[T] {
+	return project("Project", "projected", f, desc, cf)
+}
+
+// project is the implementation of Project. It allows the checkID and the
+// name of the parameter holding the derived value to be given.
+func project[T, U any](checkID, paramName string,
+	f func(T) U, desc string, cf ValCk[U],
+) ValCk[T] {
+	return func(v T) error {
+		u := f(v)
+
+		if err := cf(u); err != nil {
+			return newCheckError(checkID, v,
+				map[string]any{paramName: u, "desc": desc},
+				"the %s (%v) is incorrect: %w", desc, u, err).
+				sevOf(err)
+		}
+
+		return nil
+	}
+}
+
+// ProjectErr returns a function that will derive a value from the value
+// being checked using the projection func and then apply the supplied check
+// func to the derived value. Unlike Project, the projection func may fail
+// and if it does the check fails with an error which wraps the error from
+// the projection func. This allows, for instance, a string to be parsed
+// into a number or a time.Duration which is then checked. The description
+// should describe the derived value (for instance, "number of seconds").
+func ProjectErr[T, U any](f func(T) (U, error), desc string, cf ValCk[U],
+) ValCk[T] {
+	return func(v T) error {
+		u, err := f(v)
+		if err != nil {
+			return newCheckError("ProjectErr", v,
+				map[string]any{"desc": desc},
+				"the %s cannot be derived from the value (%v): %w",
+				desc, v, err)
+		}
+
+		if err := cf(u); err != nil {
+			return newCheckError("ProjectErr", v,
+				map[string]any{"projected": u, "desc": desc},
+				"the %s (%v) is incorrect: %w", desc, u, err).
+				sevOf(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+package check_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestProject(t *testing.T) {
+	wordCount := check.Project(
+		func(s string) int { return len(strings.Fields(s)) },
+		"number of words",
+		check.ValBetween(2, 4))
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val string
+	}{
+		{
+			ID:  testhelper.MkID("good"),
+			val: "hello world",
+		},
+		{
+			ID: testhelper.MkID("bad"),
+			ExpErr: testhelper.MkExpErr(
+				"the number of words (1) is incorrect:" +
+					" the value (1) must be between 2 and 4 - too small"),
+			val: "hello",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, wordCount(tc.val), tc)
+	}
+}
+
+func TestProjectErr(t *testing.T) {
+	intVal := check.ProjectErr(strconv.Atoi, "integer value",
+		check.ValBetween(1, 10))
+	durVal := check.ProjectErr(time.ParseDuration, "duration",
+		check.ValBetween(time.Second, time.Minute))
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[string]
+		val       string
+	}{
+		{
+			ID:        testhelper.MkID("int - good"),
+			checkFunc: intVal,
+			val:       "7",
+		},
+		{
+			ID: testhelper.MkID("int - out of range"),
+			ExpErr: testhelper.MkExpErr(
+				"the integer value (11) is incorrect:" +
+					" the value (11) must be between 1 and 10 - too big"),
+			checkFunc: intVal,
+			val:       "11",
+		},
+		{
+			ID: testhelper.MkID("int - not a number"),
+			ExpErr: testhelper.MkExpErr(
+				"the integer value cannot be derived from the value (x):",
+				"invalid syntax"),
+			checkFunc: intVal,
+			val:       "x",
+		},
+		{
+			ID:        testhelper.MkID("duration - good"),
+			checkFunc: durVal,
+			val:       "30s",
+		},
+		{
+			ID: testhelper.MkID("duration - out of range"),
+			ExpErr: testhelper.MkExpErr(
+				"the duration (2m0s) is incorrect:",
+				"too big"),
+			checkFunc: durVal,
+			val:       "2m",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, tc.checkFunc(tc.val), tc)
+	}
+}
@@ -0,0 +1,218 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Severity records how serious a check failure is
+type Severity int
+
+// These are the different severities of check failure. The zero value is
+// SevError so that, unless otherwise marked, a check failure is an error.
+const (
+	SevError   Severity = iota // the value is invalid
+	SevWarning                 // the value is valid but inadvisable
+)
+
+// String returns a string representation of the Severity
+func (s Severity) String() string {
+	switch s {
+	case SevError:
+		return "error"
+	case SevWarning:
+		return "warning"
+	}
+
+	return fmt.Sprintf("<bad Severity: %d>", int(s))
+}
+
+// Warning returns a function that applies the check but marks any failure
+// as a warning rather than an error. The error returned has the same
+// message as that returned by the check, wraps it and has a Severity of
+// SevWarning.
+//
+// A warning is still a non-nil error and most checks will treat it as a
+// failure like any other. The exceptions are And, SliceAll, MapKeyAll,
+// MapValAll (and their ...Errs, ...Parallel and ...Ctx variants) which will
+// pass over a warning and carry on checking. If there are no errors they will
+// return a warning wrapping all the warnings found, otherwise they will
+// return the error with the warnings recorded in its Warnings field. Use
+// NewResult to separate the warnings from the errors. Checks which wrap the
+// failure of a single inner check (such as FileInfoPerm, Field or
+// StringLength) keep its severity so a warning is still a warning.
+func Warning[T any](cf ValCk[T]) ValCk[T] {
+	return func(v T) error {
+		err := cf(v)
+		if err == nil {
+			return nil
+		}
+
+		return &CheckError{
+			CheckID:  "Warning",
+			Value:    v,
+			Msg:      err.Error(),
+			Errs:     []error{err},
+			Severity: SevWarning,
+		}
+	}
+}
+
+// IsWarning returns true if the error is a CheckError (or wraps one) with
+// a Severity of SevWarning. It returns false if the error is nil.
+func IsWarning(err error) bool {
+	var ce *CheckError
+	if !errors.As(err, &ce) {
+		return false
+	}
+
+	return ce.Severity == SevWarning
+}
+
+// sevOf sets the severity of the CheckError to that of the wrapped error
+// and returns the CheckError
+func (e *CheckError) sevOf(err error) *CheckError {
+	if IsWarning(err) {
+		e.Severity = SevWarning
+	}
+
+	return e
+}
+
+// warnings accumulates the warnings found by a check which passes over
+// them
+type warnings []error
+
+// result returns the error to be returned by the check. If there are no
+// warnings this is just the error. Otherwise, if the error is nil, it is a
+// warning wrapping all the warnings and if not it is an error wrapping the
+// error and recording the warnings.
+func (ws warnings) result(checkID string, v any, err error) error {
+	if len(ws) == 0 {
+		return err
+	}
+
+	if err == nil {
+		return &CheckError{
+			CheckID:    checkID,
+			Value:      v,
+			Params:     map[string]any{"count": len(ws)},
+			Msg:        errors.Join(ws...).Error(),
+			Errs:       ws,
+			Severity:   SevWarning,
+			isWarnings: true,
+		}
+	}
+
+	return &CheckError{
+		CheckID:  checkID,
+		Value:    v,
+		Msg:      err.Error(),
+		Errs:     []error{err},
+		Warnings: ws,
+	}
+}
+
+// firstErr returns the error to be returned by a check which stops at the
+// first error. The CheckErrors are those for the failing parts of the
+// value, in order; any warnings before the first error are recorded.
+func firstErr(checkID string, v any, errs []*CheckError) error {
+	var ws warnings
+
+	for _, e := range errs {
+		if e.Severity != SevWarning {
+			return ws.result(checkID, v, e)
+		}
+
+		ws = append(ws, e)
+	}
+
+	return ws.result(checkID, v, nil)
+}
+
+// allErrs returns the error to be returned by a check which reports all
+// the failing parts of the value. The CheckErrors are those for the
+// failing parts of the value, in order; the warnings are separated from
+// the errors.
+func allErrs(checkID string, v any, errs []*CheckError) error {
+	var (
+		ws      warnings
+		realErr []error
+	)
+
+	for _, e := range errs {
+		if e.Severity == SevWarning {
+			ws = append(ws, e)
+		} else {
+			realErr = append(realErr, e)
+		}
+	}
+
+	return ws.result(checkID, v, joinedErrs(checkID, v, realErr))
+}
+
+// Result separates the warnings from the error returned by a check.
+type Result struct {
+	// Err is the error returned by the check, it is nil if the check
+	// passed or if there were only warnings
+	Err error
+	// Warnings holds all the warnings found by the check
+	Warnings []error
+}
+
+// NewResult returns the Result for the error returned by a check. If the
+// error is a warning then the Result has a nil Err and the warnings (those
+// gathered together by And, SliceAll etc. are given separately). Otherwise
+// Err is the error and the warnings are any recorded in the Warnings field
+// of the error or of the errors it wraps.
+func NewResult(err error) Result {
+	if err == nil {
+		return Result{}
+	}
+
+	if IsWarning(err) {
+		return Result{Warnings: splitWarnings(err)}
+	}
+
+	return Result{Err: err, Warnings: recordedWarnings(err)}
+}
+
+// OK returns true if the check passed, possibly with warnings
+func (r Result) OK() bool {
+	return r.Err == nil
+}
+
+// splitWarnings returns the individual warnings wrapped by the warning
+func splitWarnings(err error) []error {
+	ce, ok := err.(*CheckError) //nolint:errorlint
+	if !ok || !ce.isWarnings {
+		return []error{err}
+	}
+
+	var ws []error
+	for _, e := range ce.Errs {
+		ws = append(ws, splitWarnings(e)...)
+	}
+
+	return ws
+}
+
+// recordedWarnings returns the warnings recorded in the Warnings field of
+// the error or of any of the errors it wraps
+func recordedWarnings(err error) []error {
+	ce, ok := err.(*CheckError) //nolint:errorlint
+	if !ok {
+		return nil
+	}
+
+	var ws []error
+	for _, w := range ce.Warnings {
+		ws = append(ws, splitWarnings(w)...)
+	}
+
+	for _, e := range ce.Errs {
+		ws = append(ws, recordedWarnings(e)...)
+	}
+
+	return ws
+}
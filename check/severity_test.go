@@ -0,0 +1,304 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// errMsgs returns the messages of the errors
+func errMsgs(errs []error) []string {
+	msgs := []string{}
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	return msgs
+}
+
+func TestWarning(t *testing.T) {
+	warnGT5 := check.Warning(check.ValLE(5))
+	mustBeLT10 := check.ValLT(10)
+
+	const fileName = "testdata/IsAFile.PBits0600"
+
+	_ = os.Chmod(fileName, 0o600) // force the file mode
+
+	fi, err := os.Stat(fileName)
+	if err != nil {
+		t.Fatalf("pre-test setup: cannot stat %q: %s", fileName, err)
+	}
+
+	type sT struct{ A int }
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		err         error
+		expWarning  bool
+		expWarnings []string
+	}{
+		{
+			ID:  testhelper.MkID("Warning - pass"),
+			err: warnGT5(3),
+		},
+		{
+			ID: testhelper.MkID("Warning - fail"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be less than or equal to 5"),
+			err:        warnGT5(7),
+			expWarning: true,
+			expWarnings: []string{
+				"the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("And - warning then error"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (12) must be less than 10"),
+			err: check.And(warnGT5, mustBeLT10)(12),
+			expWarnings: []string{
+				"the value (12) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("And - warnings only"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be less than or equal to 5",
+				"the value (7) must be less than or equal to 6"),
+			err: check.And(warnGT5, mustBeLT10,
+				check.Warning(check.ValLE(6)))(7),
+			expWarning: true,
+			expWarnings: []string{
+				"the value (7) must be less than or equal to 5",
+				"the value (7) must be less than or equal to 6",
+			},
+		},
+		{
+			ID: testhelper.MkID("SliceAll - warnings then error"),
+			ExpErr: testhelper.MkExpErr(
+				"list entry: 2 (11) does not pass the test:" +
+					" the value (11) must be less than 10"),
+			err: check.SliceAll[[]int](check.And(warnGT5, mustBeLT10))(
+				[]int{6, 1, 11, 7}),
+			expWarnings: []string{
+				"list entry: 0 (6) does not pass the test:" +
+					" the value (6) must be less than or equal to 5",
+				"the value (11) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("SliceAll - warnings only"),
+			ExpErr: testhelper.MkExpErr(
+				"list entry: 0 (6) does not pass the test"),
+			err: check.SliceAll[[]int](check.And(warnGT5, mustBeLT10))(
+				[]int{6, 1, 7}),
+			expWarning: true,
+			expWarnings: []string{
+				"list entry: 0 (6) does not pass the test:" +
+					" the value (6) must be less than or equal to 5",
+				"list entry: 2 (7) does not pass the test:" +
+					" the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("SliceAllErrsParallel - mixed"),
+			ExpErr: testhelper.MkExpErr(
+				"list entry: 1 (11) does not pass the test",
+				"list entry: 3 (12) does not pass the test"),
+			err: check.SliceAllErrsParallel[[]int](2,
+				check.And(warnGT5, mustBeLT10))(
+				[]int{6, 11, 1, 12}),
+			expWarnings: []string{
+				"list entry: 0 (6) does not pass the test:" +
+					" the value (6) must be less than or equal to 5",
+				"the value (11) must be less than or equal to 5",
+				"the value (12) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("MapValAllErrs - warnings only"),
+			ExpErr: testhelper.MkExpErr(
+				"map entry[a], bad value:" +
+					" the value (6) must be less than or equal to 5"),
+			err: check.MapValAllErrs[map[string]int](warnGT5)(
+				map[string]int{"a": 6, "b": 7, "c": 1}),
+			expWarning: true,
+			expWarnings: []string{
+				"map entry[a], bad value:" +
+					" the value (6) must be less than or equal to 5",
+				"map entry[b], bad value:" +
+					" the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("AndCtx - warning then error"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (12) must be less than 10"),
+			err: check.AndCtx(check.ToValCkCtx(warnGT5),
+				check.ToValCkCtx(mustBeLT10))(context.Background(), 12),
+			expWarnings: []string{
+				"the value (12) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("AndCtx - warnings only"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be less than or equal to 5"),
+			err: check.AndCtx(check.ToValCkCtx(warnGT5),
+				check.ToValCkCtx(mustBeLT10))(context.Background(), 7),
+			expWarning: true,
+			expWarnings: []string{
+				"the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("SliceAllCtx - warning then error"),
+			ExpErr: testhelper.MkExpErr(
+				"list entry: 1 (100) does not pass the test:" +
+					" the value (100) must be less than 10"),
+			err: check.SliceAllCtx[[]int](
+				check.ToValCkCtx(check.And(warnGT5, mustBeLT10)))(
+				context.Background(), []int{7, 100}),
+			expWarnings: []string{
+				"list entry: 0 (7) does not pass the test:" +
+					" the value (7) must be less than or equal to 5",
+				"the value (100) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("MapValAllCtx - warning then error"),
+			ExpErr: testhelper.MkExpErr(
+				"map entry[a], bad value:" +
+					" the value (11) must be less than 10"),
+			err: check.MapValAllCtx[map[string]int](
+				check.ToValCkCtx(check.And(warnGT5, mustBeLT10)))(
+				context.Background(), map[string]int{"a": 11}),
+			expWarnings: []string{
+				"the value (11) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("MapValAllCtx - warnings only"),
+			ExpErr: testhelper.MkExpErr(
+				"map entry[a], bad value:" +
+					" the value (6) must be less than or equal to 5"),
+			err: check.MapValAllCtx[map[string]int](
+				check.ToValCkCtx(warnGT5))(
+				context.Background(), map[string]int{"a": 6, "c": 1}),
+			expWarning: true,
+			expWarnings: []string{
+				"map entry[a], bad value:" +
+					" the value (6) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("FileInfoPerm - warning then error"),
+			ExpErr: testhelper.MkExpErr(
+				"the check on the size of \"IsAFile.PBits0600\" failed"),
+			err: check.And(
+				check.FileInfoPerm(
+					check.Warning(check.FilePermHasNone(0o400))),
+				check.FileInfoSize(check.ValGT[int64](0)))(fi),
+			expWarnings: []string{
+				"the file permissions of \"IsAFile.PBits0600\"" +
+					" are incorrect: the permissions (0600) should have" +
+					" none of the permissions in 0400",
+			},
+		},
+		{
+			ID: testhelper.MkID("FileInfoPerm - warning only"),
+			ExpErr: testhelper.MkExpErr(
+				"the file permissions of \"IsAFile.PBits0600\"" +
+					" are incorrect"),
+			err: check.FileInfoPerm(
+				check.Warning(check.FilePermHasNone(0o400)))(fi),
+			expWarning: true,
+			expWarnings: []string{
+				"the file permissions of \"IsAFile.PBits0600\"" +
+					" are incorrect: the permissions (0600) should have" +
+					" none of the permissions in 0400",
+			},
+		},
+		{
+			ID: testhelper.MkID("Field - warning only"),
+			ExpErr: testhelper.MkExpErr(
+				"the A field is incorrect:" +
+					" the value (7) must be less than or equal to 5"),
+			err: check.And(
+				check.Field("A", func(v sT) int { return v.A }, warnGT5),
+				check.Field("A", func(v sT) int { return v.A },
+					mustBeLT10))(sT{A: 7}),
+			expWarning: true,
+			expWarnings: []string{
+				"the A field is incorrect:" +
+					" the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("StringLength - warning only"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be less than or equal to 5"),
+			err:        check.StringLength[string](warnGT5)("abcdefg"),
+			expWarning: true,
+			expWarnings: []string{
+				"the length of the string (7) is incorrect:" +
+					" the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("If - warning only"),
+			ExpErr: testhelper.MkExpErr(
+				"the condition check passes so the then check applies:" +
+					" the value (7) must be less than or equal to 5"),
+			err:        check.If(check.ValGT(0), warnGT5, nil)(7),
+			expWarning: true,
+			expWarnings: []string{
+				"the condition check passes so the then check applies:" +
+					" the value (7) must be less than or equal to 5",
+			},
+		},
+		{
+			ID: testhelper.MkID("Or - a warning is a failure"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be less than or equal to 5",
+				" or ",
+				"the value (7) must be less than 0"),
+			err: check.Or(warnGT5, check.ValLT(0))(7),
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, tc.err, tc)
+		testhelper.DiffBool(t, tc.IDStr(), "IsWarning",
+			check.IsWarning(tc.err), tc.expWarning)
+
+		r := check.NewResult(tc.err)
+		testhelper.DiffBool(t, tc.IDStr(), "OK",
+			r.OK(), tc.err == nil || tc.expWarning)
+		testhelper.DiffStringSlice(t, tc.IDStr(), "warnings",
+			errMsgs(r.Warnings), tc.expWarnings)
+	}
+}
+
+func TestWarningErrorsIs(t *testing.T) {
+	baseErr := errors.New("base")
+	warn := check.Warning(func(_ int) error { return baseErr })
+
+	err := check.SliceAll[[]int](warn)([]int{1})
+	if !errors.Is(err, baseErr) {
+		t.Errorf("the warning should wrap the base error")
+	}
+
+	var ce *check.CheckError
+	if !errors.As(err, &ce) {
+		t.Fatalf("the warning should be a *check.CheckError")
+	}
+
+	testhelper.DiffString(t, "SliceAll(Warning)", "severity",
+		ce.Severity.String(), "warning")
+}
@@ -0,0 +1,245 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number is the constraint satisfied by the types of value that the
+// statistical aggregators can be applied to. Note that this includes
+// time.Duration.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// statKind records which statistic a Stat calculates
+type statKind int
+
+const (
+	statSum statKind = iota
+	statMean
+	statMin
+	statMax
+	statStdDev
+	statPercentile
+)
+
+// Stat implements the Aggregator interface. It calculates a statistic
+// (such as the mean) of the values passed to Aggregate and Test applies
+// its check to the statistic. Use NewSum, NewMean, NewMin, NewMax,
+// NewStdDev or NewPercentile to construct one and pass it to
+// SliceAggregate, MapKeyAggregate or MapValAggregate. For instance, to
+// check the mean of a list of latencies you could use
+//
+//	check.SliceAggregate[[]time.Duration](
+//		check.NewMean(check.ValLT(200 * time.Millisecond)))
+//
+// Test returns an error if there have been no values, except for a Sum
+// where the sum of no values is zero. Test starts a new aggregation so the
+// check can be applied to more than one set of values.
+//
+// Where the statistic of values of an integer type (such as a Mean) is not
+// a whole number it is rounded to the nearest.
+//
+// Aggregate returns an error if a value is NaN, as no statistic can then be
+// found, or if the sum of values of an integer type would overflow. It also
+// starts a new aggregation so a later set of values is unaffected.
+type Stat[T Number] struct {
+	checkID string
+	kind    statKind
+	desc    msg
+	p       float64
+	test    ValCk[T]
+
+	n    int
+	sum  T
+	min  T
+	max  T
+	mean float64
+	m2   float64
+	vals []T
+}
+
+// newStat returns a Stat calculating the given kind of statistic. It will
+// panic if the test is nil.
+func newStat[T Number](checkID string, kind statKind, desc msg,
+	test ValCk[T],
+) *Stat[T] {
+	if test == nil {
+		panic(fmt.Sprintf("Impossible checks passed to %s:"+
+			" no test function has been given", checkID))
+	}
+
+	return &Stat[T]{
+		checkID: checkID,
+		kind:    kind,
+		desc:    desc,
+		test:    test,
+	}
+}
+
+// NewSum returns a Stat that applies the test to the sum of the values. It
+// will panic if the test is nil; this applies to all the Stat
+// constructors.
+func NewSum[T Number](test ValCk[T]) *Stat[T] {
+	return newStat("NewSum", statSum, mkMsg("sum"), test)
+}
+
+// NewMean returns a Stat that applies the test to the mean of the values
+func NewMean[T Number](test ValCk[T]) *Stat[T] {
+	return newStat("NewMean", statMean, mkMsg("mean"), test)
+}
+
+// NewMin returns a Stat that applies the test to the smallest of the values
+func NewMin[T Number](test ValCk[T]) *Stat[T] {
+	return newStat("NewMin", statMin, mkMsg("minimum"), test)
+}
+
+// NewMax returns a Stat that applies the test to the largest of the values
+func NewMax[T Number](test ValCk[T]) *Stat[T] {
+	return newStat("NewMax", statMax, mkMsg("maximum"), test)
+}
+
+// NewStdDev returns a Stat that applies the test to the (population)
+// standard deviation of the values
+func NewStdDev[T Number](test ValCk[T]) *Stat[T] {
+	return newStat("NewStdDev", statStdDev,
+		mkMsg("standard deviation"), test)
+}
+
+// NewPercentile returns a Stat that applies the test to the p'th
+// percentile of the values. This is found by the nearest-rank method and
+// so is the smallest of the values such that at least p percent of the
+// values are less than or equal to it. For instance, to check that 95% of
+// a list of latencies are below one second you could use
+//
+//	check.SliceAggregate[[]time.Duration](
+//		check.NewPercentile(95, check.ValLT(time.Second)))
+//
+// Unlike the other statistics, all of the values must be kept until Test
+// is called. It will panic if p is not between 0 and 100.
+func NewPercentile[T Number](p float64, test ValCk[T]) *Stat[T] {
+	if !(p >= 0 && p <= 100) {
+		panic(fmt.Sprintf("Impossible checks passed to NewPercentile:"+
+			" the percentile (%v) must be between 0 and 100", p))
+	}
+
+	desc := mkMsg("%vth percentile", p)
+	if p == math.Trunc(p) {
+		desc = mkMsg("%v percentile", ordinal(int(p)))
+	}
+
+	s := newStat("NewPercentile", statPercentile, desc, test)
+	s.p = p
+
+	return s
+}
+
+// Aggregate adds the value to the statistic
+func (s *Stat[T]) Aggregate(v T) error {
+	if math.IsNaN(float64(v)) {
+		defer s.reset()
+
+		return newCheckError(s.checkID, v, map[string]any{"index": s.n},
+			"the %v cannot be found as value %d is NaN", s.desc, s.n)
+	}
+
+	sum := s.sum + v
+	if s.kind == statSum && (v > 0 && sum < s.sum || v < 0 && sum > s.sum) {
+		defer s.reset()
+
+		return newCheckError(s.checkID, v, map[string]any{"index": s.n},
+			"the %v cannot be found as it overflows at value %d (%v)",
+			s.desc, s.n, v)
+	}
+
+	s.n++
+	s.sum = sum
+
+	if s.n == 1 || v < s.min {
+		s.min = v
+	}
+
+	if s.n == 1 || v > s.max {
+		s.max = v
+	}
+
+	// Welford's method avoids the loss of precision from subtracting
+	// large sums of squares
+	delta := float64(v) - s.mean
+	s.mean += delta / float64(s.n)
+	s.m2 += delta * (float64(v) - s.mean)
+
+	if s.kind == statPercentile {
+		s.vals = append(s.vals, v)
+	}
+
+	return nil
+}
+
+// Test applies the test to the statistic and starts a new aggregation
+func (s *Stat[T]) Test() error {
+	defer s.reset()
+
+	if s.n == 0 && s.kind != statSum {
+		return newCheckError(s.checkID, nil, map[string]any{"count": 0},
+			"the %v cannot be found as there are no values", s.desc)
+	}
+
+	stat := s.stat()
+
+	if err := s.test(stat); err != nil {
+		return newCheckError(s.checkID, stat,
+			map[string]any{"count": s.n, "desc": s.desc.String()},
+			"the %v (%v) is incorrect: %w", s.desc, stat, err).
+			sevOf(err)
+	}
+
+	return nil
+}
+
+// stat returns the statistic. There must be at least one value unless the
+// statistic is the sum.
+func (s *Stat[T]) stat() T {
+	switch s.kind {
+	case statMean:
+		return fromFloat[T](s.mean)
+	case statMin:
+		return s.min
+	case statMax:
+		return s.max
+	case statStdDev:
+		return fromFloat[T](math.Sqrt(s.m2 / float64(s.n)))
+	case statPercentile:
+		slices.Sort(s.vals)
+
+		const hundred = 100
+
+		rank := int(math.Ceil(s.p * float64(len(s.vals)) / hundred))
+
+		return s.vals[max(rank, 1)-1]
+	}
+
+	return s.sum
+}
+
+// reset clears the statistic so that a new aggregation can be started
+func (s *Stat[T]) reset() {
+	s.n = 0
+	s.sum, s.min, s.max = 0, 0, 0
+	s.mean, s.m2 = 0, 0
+	s.vals = s.vals[:0]
+}
+
+// fromFloat converts the float to the Number type, rounding it to the
+// nearest whole number if the type is an integer
+func fromFloat[T Number](f float64) T {
+	if T(1)/2 == 0 {
+		return T(math.Round(f))
+	}
+
+	return T(f)
+}
@@ -0,0 +1,268 @@
+package check_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestStat(t *testing.T) {
+	vals := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		stat *check.Stat[int]
+		vals []int
+	}{
+		{
+			ID:   testhelper.MkID("Sum: good"),
+			stat: check.NewSum(check.ValEQ(40)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("Sum: no values"),
+			stat: check.NewSum(check.ValEQ(0)),
+		},
+		{
+			ID: testhelper.MkID("Sum: bad"),
+			ExpErr: testhelper.MkExpErr("the sum (40) is incorrect: ",
+				"must be less than 10"),
+			stat: check.NewSum(check.ValLT(10)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("Mean: good"),
+			stat: check.NewMean(check.ValEQ(5)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("Mean: rounded"),
+			stat: check.NewMean(check.ValEQ(2)),
+			vals: []int{1, 2, 2},
+		},
+		{
+			ID: testhelper.MkID("Mean: no values"),
+			ExpErr: testhelper.MkExpErr(
+				"the mean cannot be found as there are no values"),
+			stat: check.NewMean(check.ValEQ(0)),
+		},
+		{
+			ID:   testhelper.MkID("Min: good"),
+			stat: check.NewMin(check.ValEQ(2)),
+			vals: vals,
+		},
+		{
+			ID:     testhelper.MkID("Min: bad"),
+			ExpErr: testhelper.MkExpErr("the minimum (2) is incorrect"),
+			stat:   check.NewMin(check.ValGT(2)),
+			vals:   vals,
+		},
+		{
+			ID:   testhelper.MkID("Max: good"),
+			stat: check.NewMax(check.ValEQ(9)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("StdDev: good"),
+			stat: check.NewStdDev(check.ValEQ(2)),
+			vals: vals,
+		},
+		{
+			ID: testhelper.MkID("StdDev: bad"),
+			ExpErr: testhelper.MkExpErr(
+				"the standard deviation (2) is incorrect"),
+			stat: check.NewStdDev(check.ValLT(1)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("Percentile(50): good"),
+			stat: check.NewPercentile(50, check.ValEQ(4)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("Percentile(0): the minimum"),
+			stat: check.NewPercentile(0, check.ValEQ(2)),
+			vals: vals,
+		},
+		{
+			ID:   testhelper.MkID("Percentile(100): the maximum"),
+			stat: check.NewPercentile(100, check.ValEQ(9)),
+			vals: vals,
+		},
+		{
+			ID:     testhelper.MkID("Percentile(90): bad"),
+			ExpErr: testhelper.MkExpErr("the 90th percentile (9) is incorrect"),
+			stat:   check.NewPercentile(90, check.ValLT(9)),
+			vals:   vals,
+		},
+		{
+			ID: testhelper.MkID("Percentile(87.5): bad"),
+			ExpErr: testhelper.MkExpErr(
+				"the 87.5th percentile (7) is incorrect"),
+			stat: check.NewPercentile(87.5, check.ValLT(7)),
+			vals: vals,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := check.SliceAggregate[[]int](tc.stat)(tc.vals)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestStatPercentileRank(t *testing.T) {
+	vals := make([]int, 0, 20)
+	for i := 1; i <= 20; i++ {
+		vals = append(vals, i)
+	}
+
+	ck := check.SliceAggregate[[]int](check.NewPercentile(95, check.ValEQ(19)))
+	if err := ck(vals); err != nil {
+		t.Error("the 95th percentile of 1 to 20 should be 19:", err)
+	}
+}
+
+func TestStatDuration(t *testing.T) {
+	latencies := map[string]time.Duration{
+		"a": 100 * time.Millisecond,
+		"b": 150 * time.Millisecond,
+		"c": 350 * time.Millisecond,
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		stat *check.Stat[time.Duration]
+	}{
+		{
+			ID:   testhelper.MkID("Mean: below 250ms"),
+			stat: check.NewMean(check.ValLT(250 * time.Millisecond)),
+		},
+		{
+			ID: testhelper.MkID("Mean: not below 200ms"),
+			ExpErr: testhelper.MkExpErr(
+				"the mean (200ms) is incorrect",
+				"must be less than 200ms"),
+			stat: check.NewMean(check.ValLT(200 * time.Millisecond)),
+		},
+		{
+			ID: testhelper.MkID("Percentile(95): not below 300ms"),
+			ExpErr: testhelper.MkExpErr(
+				"the 95th percentile (350ms) is incorrect"),
+			stat: check.NewPercentile(95,
+				check.ValLT(300*time.Millisecond)),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := check.MapValAggregate[map[string]time.Duration](tc.stat)(
+			latencies)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestStatReuse(t *testing.T) {
+	ck := check.SliceAggregate[[]float64](check.NewMean(check.ValLT(2.0)))
+
+	if err := ck([]float64{1, 2}); err != nil {
+		t.Error("unexpected error:", err)
+	}
+
+	if err := ck([]float64{1.5, 1.5}); err != nil {
+		t.Error("the aggregation should start afresh:", err)
+	}
+}
+
+func TestStatBadValues(t *testing.T) {
+	minStat := check.NewMin(check.ValGT(0.0))
+	sumStat := check.NewSum(check.ValGT[int8](0))
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		err error
+	}{
+		{
+			ID: testhelper.MkID("Min: first value NaN"),
+			ExpErr: testhelper.MkExpErr(
+				"the minimum cannot be found as value 0 is NaN"),
+			err: check.SliceAggregate[[]float64](minStat)(
+				[]float64{math.NaN(), 1, 2}),
+		},
+		{
+			ID: testhelper.MkID("Min: later value NaN"),
+			ExpErr: testhelper.MkExpErr(
+				"the minimum cannot be found as value 2 is NaN"),
+			err: check.SliceAggregate[[]float64](minStat)(
+				[]float64{1, 2, math.NaN()}),
+		},
+		{
+			ID:  testhelper.MkID("Min: good after a NaN"),
+			err: check.SliceAggregate[[]float64](minStat)([]float64{1, 2}),
+		},
+		{
+			ID: testhelper.MkID("Sum: overflow"),
+			ExpErr: testhelper.MkExpErr(
+				"the sum cannot be found as it overflows at value 2 (100)"),
+			err: check.SliceAggregate[[]int8](sumStat)(
+				[]int8{20, 10, 100}),
+		},
+		{
+			ID: testhelper.MkID("Sum: negative overflow"),
+			ExpErr: testhelper.MkExpErr(
+				"the sum cannot be found as it overflows at value 1 (-100)"),
+			err: check.SliceAggregate[[]int8](sumStat)(
+				[]int8{-100, -100}),
+		},
+		{
+			ID:  testhelper.MkID("Sum: good after an overflow"),
+			err: check.SliceAggregate[[]int8](sumStat)([]int8{100, 27}),
+		},
+		{
+			ID: testhelper.MkID("Mean: no overflow"),
+			err: check.SliceAggregate[[]int8](
+				check.NewMean(check.ValEQ[int8](100)))(
+				[]int8{100, 100}),
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, tc.err, tc)
+	}
+}
+
+func TestStatPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("Mean: nil test"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to NewMean:" +
+					" no test function has been given"),
+			f: func() { check.NewMean[int](nil) },
+		},
+		{
+			ID: testhelper.MkID("Percentile: too big"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to NewPercentile:" +
+					" the percentile (101) must be between 0 and 100"),
+			f: func() { check.NewPercentile(101, check.ValLT(1)) },
+		},
+		{
+			ID: testhelper.MkID("Percentile: good"),
+			f:  func() { check.NewPercentile(99.9, check.ValLT(1)) },
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
@@ -6,106 +6,93 @@ import (
 	"strings"
 	"time"
 
-	"github.com/nickwells/english.mod/english"
 	"github.com/nickwells/tempus.mod/tempus"
 )
 
 // TimeEQ returns a function that will check that the tested time is equal to
-// the time.Time parameters
+// the time.Time parameters. As with all the time comparison checks, times
+// are compared with time.Time.Compare so the same instant in different
+// locations is equal.
 func TimeEQ(t time.Time) ValCk[time.Time] {
-	return func(val time.Time) error {
-		if val.Equal(t) {
-			return nil
-		}
-
-		return fmt.Errorf("the time (%s) must equal %s", val, t)
-	}
+	return cmpCk(t, time.Time.Compare, isEQ,
+		func(val time.Time) error {
+			return newCheckError("TimeEQ", val, map[string]any{"t": t},
+				"the time (%s) must equal %s", val, t)
+		})
 }
 
 // TimeNE returns a function that will check that the tested time is not
 // equal to the time.Time parameters
 func TimeNE(t time.Time) ValCk[time.Time] {
-	return func(val time.Time) error {
-		if val != t {
-			return nil
-		}
-
-		return fmt.Errorf("the time must not equal %s", t)
-	}
+	return cmpCk(t, time.Time.Compare, isNE,
+		func(val time.Time) error {
+			return newCheckError("TimeNE", val, map[string]any{"t": t},
+				"the time must not equal %s", t)
+		})
 }
 
 // TimeGT returns a function that will check that the tested time is after
 // the time.Time parameter
 func TimeGT(t time.Time) ValCk[time.Time] {
-	return func(val time.Time) error {
-		if val.After(t) {
-			return nil
-		}
-
-		return fmt.Errorf("the time (%s) must be after %s", val, t)
-	}
+	return cmpCk(t, time.Time.Compare, isGT,
+		func(val time.Time) error {
+			return newCheckError("TimeGT", val, map[string]any{"t": t},
+				"the time (%s) must be after %s", val, t)
+		})
 }
 
 // TimeGE returns a function that will check that the tested time is after
 // or equal to the time.Time parameter
 func TimeGE(t time.Time) ValCk[time.Time] {
-	return func(val time.Time) error {
-		if val.After(t) || val.Equal(t) {
-			return nil
-		}
-
-		return fmt.Errorf("the time (%s) must be at or after %s", val, t)
-	}
+	return cmpCk(t, time.Time.Compare, isGE,
+		func(val time.Time) error {
+			return newCheckError("TimeGE", val, map[string]any{"t": t},
+				"the time (%s) must be at or after %s", val, t)
+		})
 }
 
 // TimeLT returns a function that will check that the tested time is before
 // the time.Time parameter
 func TimeLT(t time.Time) ValCk[time.Time] {
-	return func(val time.Time) error {
-		if val.Before(t) {
-			return nil
-		}
-
-		return fmt.Errorf("the time (%s) must be before %s", val, t)
-	}
+	return cmpCk(t, time.Time.Compare, isLT,
+		func(val time.Time) error {
+			return newCheckError("TimeLT", val, map[string]any{"t": t},
+				"the time (%s) must be before %s", val, t)
+		})
 }
 
 // TimeLE returns a function that will check that the tested time is before
 // or equal to the time.Time parameter
 func TimeLE(t time.Time) ValCk[time.Time] {
-	return func(val time.Time) error {
-		if val.Before(t) || val.Equal(t) {
-			return nil
-		}
-
-		return fmt.Errorf("the time (%s) must be at or before %s", val, t)
-	}
+	return cmpCk(t, time.Time.Compare, isLE,
+		func(val time.Time) error {
+			return newCheckError("TimeLE", val, map[string]any{"t": t},
+				"the time (%s) must be at or before %s", val, t)
+		})
 }
 
 // TimeBetween returns a function that will check that the tested time is
 // between the start and end times (inclusive)
 func TimeBetween(start, end time.Time) ValCk[time.Time] {
-	if start.After(end) || start.Equal(end) {
+	if start.Compare(end) >= 0 {
 		panic(fmt.Errorf("impossible checks passed to TimeBetween:"+
 			" the start time (%v) must be before the end time (%v)",
 			start, end))
 	}
 
-	return func(val time.Time) error {
-		if val.Before(start) {
-			return fmt.Errorf(
+	return betweenCk(start, end, time.Time.Compare,
+		func(val time.Time) error {
+			return newCheckError("TimeBetween", val,
+				map[string]any{"start": start, "end": end},
 				"the time (%s) must be between %v and %v (too early)",
 				val, start, end)
-		}
-
-		if val.After(end) {
-			return fmt.Errorf(
+		},
+		func(val time.Time) error {
+			return newCheckError("TimeBetween", val,
+				map[string]any{"start": start, "end": end},
 				"the time (%s) must be between %v and %v (too late)",
 				val, start, end)
-		}
-
-		return nil
-	}
+		})
 }
 
 // dowValid returns an error if the passed weekday is not between Sunday and
@@ -177,14 +164,16 @@ func TimeIsOnDOW(dow time.Weekday, otherDOW ...time.Weekday) ValCk[time.Time] {
 			return nil
 		}
 
-		dayNames := []string{}
+		dayNames := list{conj: "or"}
 
 		for _, d := range days {
-			dayNames = append(dayNames, d.String())
+			dayNames.items = append(dayNames.items, d)
 		}
 
-		return fmt.Errorf("the day of the week (%s) must be a %s",
-			valDow, english.Join(dayNames, ", ", " or "))
+		return newCheckError("TimeIsOnDOW", val,
+			map[string]any{"days": days},
+			"the day of the week (%s) must be a %s",
+			valDow, dayNames)
 	}
 }
 
@@ -194,7 +183,8 @@ func TimeIsALeapYear(t time.Time) error {
 		return nil
 	}
 
-	return fmt.Errorf("the year (%d) is not a leap year", t.Year())
+	return newCheckError("TimeIsALeapYear", t, nil,
+		"the year (%d) is not a leap year", t.Year())
 }
 
 // daysFromStartOfMonth returns the number of days from the start of the
@@ -228,7 +218,8 @@ func TimeIsNthWeekdayOfMonth(n int, dow time.Weekday) ValCk[time.Time] {
 	return func(val time.Time) error {
 		valDow := val.Weekday()
 		if valDow != dow {
-			return fmt.Errorf(
+			return newCheckError("TimeIsNthWeekdayOfMonth", val,
+				map[string]any{"n": n, "dow": dow},
 				"the day of the week is not %s (it is %s)",
 				dow, valDow)
 		}
@@ -237,19 +228,21 @@ func TimeIsNthWeekdayOfMonth(n int, dow time.Weekday) ValCk[time.Time] {
 
 		var fromEnd bool
 
+		wantWk := n
 		if n > 0 {
 			valDom = daysFromStartOfMonth(val)
 		} else {
-			n = -n
+			wantWk = -n
 			valDom = daysFromEndOfMonth(val)
 			fromEnd = true
 		}
 
 		wk := (valDom / tempus.DaysPerWeek) + 1
-		if n != wk {
-			return fmt.Errorf(
+		if wantWk != wk {
+			return newCheckError("TimeIsNthWeekdayOfMonth", val,
+				map[string]any{"n": n, "dow": dow},
 				"the day is not the %s of the month (it is the %s)",
-				expectedDowDesc(n, fromEnd, dow),
+				expectedDowDesc(wantWk, fromEnd, dow),
 				actualDowDesc(wk, fromEnd))
 		}
 
@@ -258,28 +251,27 @@ func TimeIsNthWeekdayOfMonth(n int, dow time.Weekday) ValCk[time.Time] {
 }
 
 // expectedDowDesc returns a description of the day of the week within a month
-func expectedDowDesc(n int, fromEnd bool, dow time.Weekday) string {
+func expectedDowDesc(n int, fromEnd bool, dow time.Weekday) msg {
 	if fromEnd {
 		if n == 1 {
-			return "last " + dow.String()
+			return mkMsg("last %s", dow)
 		}
 
-		return fmt.Sprintf("%d%s %s from the end",
-			n, english.OrdinalSuffix(n), dow)
+		return mkMsg("%s %s from the end", ordinal(n), dow)
 	}
 
-	return fmt.Sprintf("%d%s %s", n, english.OrdinalSuffix(n), dow)
+	return mkMsg("%s %s", ordinal(n), dow)
 }
 
 // actualDowDesc returns a description of the day of the week within a month
-func actualDowDesc(n int, fromEnd bool) string {
+func actualDowDesc(n int, fromEnd bool) msg {
 	if fromEnd {
 		if n == 1 {
-			return "last"
+			return mkMsg("last")
 		}
 
-		return fmt.Sprintf("%d%s from the end", n, english.OrdinalSuffix(n))
+		return mkMsg("%s from the end", ordinal(n))
 	}
 
-	return fmt.Sprintf("%d%s", n, english.OrdinalSuffix(n))
+	return mkMsg("%s", ordinal(n))
 }
@@ -24,7 +24,8 @@ func ValEQ[T comparable](limit T) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%v) must equal %v", v, limit)
+		return newCheckError("ValEQ", v, map[string]any{"limit": limit},
+			"the value (%v) must equal %v", v, limit)
 	}
 }
 
@@ -36,7 +37,8 @@ func ValNE[T comparable](limit T) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%v) must not equal %v", v, limit)
+		return newCheckError("ValNE", v, map[string]any{"limit": limit},
+			"the value (%v) must not equal %v", v, limit)
 	}
 }
 
@@ -48,7 +50,8 @@ func ValGT[T cmp.Ordered](limit T) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%v) must be greater than %v", v, limit)
+		return newCheckError("ValGT", v, map[string]any{"limit": limit},
+			"the value (%v) must be greater than %v", v, limit)
 	}
 }
 
@@ -60,8 +63,8 @@ func ValGE[T cmp.Ordered](limit T) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%v) must be greater than or equal to %v",
-			v, limit)
+		return newCheckError("ValGE", v, map[string]any{"limit": limit},
+			"the value (%v) must be greater than or equal to %v", v, limit)
 	}
 }
 
@@ -73,7 +76,8 @@ func ValLT[T cmp.Ordered](limit T) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%v) must be less than %v", v, limit)
+		return newCheckError("ValLT", v, map[string]any{"limit": limit},
+			"the value (%v) must be less than %v", v, limit)
 	}
 }
 
@@ -85,8 +89,8 @@ func ValLE[T cmp.Ordered](limit T) ValCk[T] {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%v) must be less than or equal to %v",
-			v, limit)
+		return newCheckError("ValLE", v, map[string]any{"limit": limit},
+			"the value (%v) must be less than or equal to %v", v, limit)
 	}
 }
 
@@ -101,13 +105,15 @@ func ValBetween[T cmp.Ordered](low, high T) ValCk[T] {
 
 	return func(v T) error {
 		if v < low {
-			return fmt.Errorf(
+			return newCheckError("ValBetween", v,
+				map[string]any{"low": low, "high": high},
 				"the value (%v) must be between %v and %v - too small",
 				v, low, high)
 		}
 
 		if v > high {
-			return fmt.Errorf(
+			return newCheckError("ValBetween", v,
+				map[string]any{"low": low, "high": high},
 				"the value (%v) must be between %v and %v - too big",
 				v, low, high)
 		}
@@ -117,25 +123,27 @@ func ValBetween[T cmp.Ordered](low, high T) ValCk[T] {
 }
 
 // ValDivides returns a function that will check that the value
-// is a divisor of d
+// is a divisor of d. Zero is only a divisor of zero.
 func ValDivides[T constraints.Integer](d T) ValCk[T] {
 	return func(v T) error {
-		if d%v == 0 {
+		if (v == 0 && d == 0) || (v != 0 && d%v == 0) {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%d) must be a divisor of %d", v, d)
+		return newCheckError("ValDivides", v, map[string]any{"d": d},
+			"the value (%d) must be a divisor of %d", v, d)
 	}
 }
 
 // ValIsAMultiple returns a function that will check that the value
-// is a multiple of d
+// is a multiple of d. The only multiple of zero is zero.
 func ValIsAMultiple[T constraints.Integer](d T) ValCk[T] {
 	return func(v T) error {
-		if v%d == 0 {
+		if (d == 0 && v == 0) || (d != 0 && v%d == 0) {
 			return nil
 		}
 
-		return fmt.Errorf("the value (%d) must be a multiple of %d", v, d)
+		return newCheckError("ValIsAMultiple", v, map[string]any{"d": d},
+			"the value (%d) must be a multiple of %d", v, d)
 	}
 }
@@ -0,0 +1,643 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check/internal/keyorder"
+)
+
+// ValidateTag is the name of the struct tag which Validate uses
+const ValidateTag = "check"
+
+// Validate checks the value against the rules given in the "check" tags on
+// the fields of any structs it contains. It recurses into nested structs,
+// pointers to structs and the entries of slices, arrays and maps. Embedded
+// structs are checked even if their type is unexported. A value reached
+// through a pointer, or the contents of a map or slice, is only checked once
+// so cyclic structures are safe. Every field is checked and the returned
+// error (if any) wraps an error for each field that fails its checks. Each
+// of these errors is a CheckError whose message starts with the path to the
+// failing field and whose Loc holds that path (see ErrPath).
+//
+// A tag holds a comma-separated list of rules, for instance
+//
+//	Port int    `check:"ge=1,le=65535"`
+//	Dir  string `check:"prefix=/,len>0"`
+//
+// The available rules are:
+//
+//	eq=V ne=V gt=V ge=V lt=V le=V
+//
+// which compare the field with the value V using ValEQ, ValGT etc (or
+// TimeEQ, TimeGT etc for time.Time fields). These can be applied to
+// fields holding numbers, strings, durations and times. Durations are
+// given in the form accepted by time.ParseDuration and times in RFC 3339
+// format.
+//
+//	len=N len!=N len>N len>=N len<N len<=N
+//
+// which check the length of a string, slice or map.
+//
+//	prefix=S suffix=S contains=S match=RE
+//
+// which check string fields using StringHasPrefix, StringHasSuffix,
+// StringContains and StringMatchesPattern. Note that the value cannot
+// contain a comma.
+//
+//	nodups
+//
+// which checks that a slice has no duplicate entries with SliceHasNoDups.
+// If the entries have an interface type then any entry holding a value
+// which is not comparable fails the check.
+//
+//	nonzero
+//
+// which checks that the field does not have the zero value for its type.
+//
+// Rules on pointer fields are applied to the value pointed to; they are not
+// applied if the pointer is nil.
+//
+// If a tag is invalid an error describing the problem is returned; this
+// will not be a CheckError.
+func Validate(v any) error {
+	vr := &validator{seen: map[visitKey]bool{}}
+
+	if err := vr.validateVal(reflect.ValueOf(v), Path{}); err != nil {
+		return err
+	}
+
+	return joinedErrs("Validate", v, vr.errs)
+}
+
+// visitKey identifies a value reached through a pointer or the contents of
+// a map or slice. The type is needed as a struct and its first field have
+// the same address and the length as a slice and a shorter slice of it
+// have the same address.
+type visitKey struct {
+	ptr uintptr
+	t   reflect.Type
+	n   int
+}
+
+// validator holds the state of a single call to Validate
+type validator struct {
+	errs []error
+	seen map[visitKey]bool
+}
+
+// fieldRule is the type of a check applied to a field value
+type fieldRule struct {
+	text string
+	ck   func(rv reflect.Value) error
+}
+
+// fieldInfo records the rules for a struct field
+type fieldInfo struct {
+	name  string
+	index int
+	rules []fieldRule
+}
+
+// structRules caches the fieldInfo for each struct type (or the error found
+// when parsing the tags)
+var structRules sync.Map
+
+// structRulesResult is the value stored in the structRules cache
+type structRulesResult struct {
+	fields []fieldInfo
+	err    error
+}
+
+// typeTime is the reflect.Type of a time.Time
+var typeTime = reflect.TypeFor[time.Time]()
+
+// typeDuration is the reflect.Type of a time.Duration
+var typeDuration = reflect.TypeFor[time.Duration]()
+
+// firstVisit returns true if the pointer, map or slice value has not been
+// seen before during this call to Validate and records that it has now
+// been seen.
+func (vr *validator) firstVisit(rv reflect.Value) bool {
+	key := visitKey{ptr: rv.Pointer(), t: rv.Type()}
+	if rv.Kind() != reflect.Pointer {
+		key.n = rv.Len()
+	}
+
+	if vr.seen[key] {
+		return false
+	}
+
+	vr.seen[key] = true
+
+	return true
+}
+
+// validateVal recursively checks the value, adding any failures to vr.errs. A
+// non-nil error is returned if a bad tag is found. Values reached through a
+// pointer, or the contents of a map or slice, which have already been
+// checked are not checked again so that cyclic data structures can be
+// validated.
+func (vr *validator) validateVal(rv reflect.Value, path Path) error {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+
+		if rv.Kind() == reflect.Pointer && !vr.firstVisit(rv) {
+			return nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	if (rv.Kind() == reflect.Map || rv.Kind() == reflect.Slice) &&
+		rv.Len() > 0 && !vr.firstVisit(rv) {
+		return nil
+	}
+
+	switch rv.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		if rv.Type() == typeTime {
+			return nil
+		}
+
+		return vr.validateStruct(rv, path)
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			err := vr.validateVal(rv.Index(i), append(path, IndexElem(i)))
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, e := range sortedMapEntries(rv) {
+			var key any = e.k.String()
+			if e.k.CanInterface() {
+				key = e.k.Interface()
+			}
+
+			err := vr.validateVal(e.v, append(path, KeyElem(key)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mapEntry holds a key from a map value and its value
+type mapEntry struct {
+	k, v reflect.Value
+}
+
+// sortedMapEntries returns the entries of the map value in key order. The
+// keys and values are collected together as a key which is not equal to
+// itself (such as a NaN) cannot be used to find its value.
+func sortedMapEntries(rv reflect.Value) []mapEntry {
+	entries := make([]mapEntry, 0, rv.Len())
+
+	for iter := rv.MapRange(); iter.Next(); {
+		entries = append(entries, mapEntry{k: iter.Key(), v: iter.Value()})
+	}
+
+	slices.SortFunc(entries, func(a, b mapEntry) int {
+		return keyorder.Compare(a.k, b.k)
+	})
+
+	return entries
+}
+
+// validateStruct applies the tag rules to the fields of the struct and
+// then recursively checks the field values
+func (vr *validator) validateStruct(rv reflect.Value, path Path) error {
+	fields, err := getStructRules(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, fi := range fields {
+		fv := rv.Field(fi.index)
+		fPath := append(slices.Clip(path), FieldElem(fi.name))
+
+		for _, r := range fi.rules {
+			if err := r.ck(fv); err != nil {
+				var val any
+				if fv.CanInterface() {
+					val = fv.Interface()
+				}
+
+				vr.errs = append(vr.errs, newCheckError("Validate", val,
+					map[string]any{"field": fi.name, "rule": r.text},
+					"%s: %w", strings.TrimPrefix(fPath.String(), "."), err).
+					at(fPath...))
+
+				break
+			}
+		}
+
+		if err := vr.validateVal(fv, fPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getStructRules returns the fieldInfo for the struct type, parsing the
+// tags if they have not already been parsed
+func getStructRules(t reflect.Type) ([]fieldInfo, error) {
+	if r, ok := structRules.Load(t); ok {
+		res := r.(structRulesResult) //nolint:forcetypeassert
+
+		return res.fields, res.err
+	}
+
+	fields, err := parseStructRules(t)
+	structRules.Store(t, structRulesResult{fields: fields, err: err})
+
+	return fields, err
+}
+
+// parseStructRules parses the tags on the fields of the struct type
+func parseStructRules(t reflect.Type) ([]fieldInfo, error) {
+	fields := []fieldInfo{}
+
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup(ValidateTag)
+
+		if !sf.IsExported() {
+			if hasTag {
+				return nil, fmt.Errorf("bad %q tag on %s.%s:"+
+					" the field is not exported",
+					ValidateTag, t.Name(), sf.Name)
+			}
+
+			// the exported fields of an embedded struct are promoted and so
+			// must be checked even if the struct type is unexported
+			if !sf.Anonymous || !isStructOrPtr(sf.Type) {
+				continue
+			}
+		}
+
+		fi := fieldInfo{name: sf.Name, index: i}
+
+		for _, text := range strings.Split(tag, ",") {
+			text = strings.TrimSpace(text)
+			if text == "" {
+				continue
+			}
+
+			r, err := parseRule(sf.Type, text)
+			if err != nil {
+				return nil, fmt.Errorf("bad %q tag on %s.%s: rule %q: %w",
+					ValidateTag, t.Name(), sf.Name, text, err)
+			}
+
+			fi.rules = append(fi.rules, r)
+		}
+
+		fields = append(fields, fi)
+	}
+
+	return fields, nil
+}
+
+// isStructOrPtr returns true if the type is a struct or a pointer to a
+// struct
+func isStructOrPtr(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
+
+// parseRule returns the fieldRule corresponding to the rule text
+func parseRule(t reflect.Type, text string) (fieldRule, error) {
+	if text == "nonzero" {
+		return fieldRule{text: text, ck: nonZero}, nil
+	}
+
+	baseType := t
+	for baseType.Kind() == reflect.Pointer {
+		baseType = baseType.Elem()
+	}
+
+	ck, err := parseBaseRule(baseType, text)
+	if err != nil {
+		return fieldRule{}, err
+	}
+
+	return fieldRule{
+		text: text,
+		ck: func(rv reflect.Value) error {
+			for rv.Kind() == reflect.Pointer {
+				if rv.IsNil() {
+					return nil
+				}
+
+				rv = rv.Elem()
+			}
+
+			return ck(rv)
+		},
+	}, nil
+}
+
+// nonZero checks that the value is not the zero value for its type
+func nonZero(rv reflect.Value) error {
+	if !rv.IsZero() {
+		return nil
+	}
+
+	return newCheckError("nonzero", nil, nil,
+		"the value must not be the zero value of its type (%s)", rv.Type())
+}
+
+// lenOps lists the operators which can follow "len" in a rule. Longer
+// operators must come before their prefixes.
+var lenOps = []string{">=", "<=", "!=", "==", ">", "<", "="}
+
+// parseBaseRule returns a check function corresponding to the rule text
+// for a value of the given (non-pointer) type
+func parseBaseRule(t reflect.Type, text string,
+) (func(rv reflect.Value) error, error) {
+	if text == "nodups" {
+		return noDupsRule(t)
+	}
+
+	if rest, ok := strings.CutPrefix(text, "len"); ok {
+		for _, op := range lenOps {
+			if val, ok := strings.CutPrefix(rest, op); ok {
+				return lenRule(t, op, val)
+			}
+		}
+
+		return nil, errors.New("unknown length comparison")
+	}
+
+	name, val, ok := strings.Cut(text, "=")
+	if !ok {
+		return nil, errors.New("unknown rule")
+	}
+
+	switch name {
+	case "eq", "ne", "gt", "ge", "lt", "le":
+		return cmpRule(t, name, val)
+	case "prefix", "suffix", "contains", "match":
+		return stringRule(t, name, val)
+	}
+
+	return nil, errors.New("unknown rule")
+}
+
+// noDupsRule returns a check that a slice has no duplicates
+func noDupsRule(t reflect.Type) (func(rv reflect.Value) error, error) {
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return nil, fmt.Errorf("the field must be a slice or array, not %s", t)
+	}
+
+	if !t.Elem().Comparable() {
+		return nil, fmt.Errorf("the entries (%s) are not comparable", t.Elem())
+	}
+
+	return func(rv reflect.Value) error {
+		// an entry of an interface type is only comparable if the value it
+		// holds is; comparing it otherwise would panic
+		for i := range rv.Len() {
+			if e := rv.Index(i); !e.Comparable() {
+				return newCheckError("nodups", nil,
+					map[string]any{"index": i},
+					"entry %d (%s) is not comparable",
+					i, reflect.TypeOf(e.Interface()))
+			}
+		}
+
+		return SliceHasNoDups(anySlice(rv))
+	}, nil
+}
+
+// anySlice returns the entries of the slice or array value as a []any
+func anySlice(rv reflect.Value) []any {
+	s := make([]any, 0, rv.Len())
+	for i := range rv.Len() {
+		s = append(s, rv.Index(i).Interface())
+	}
+
+	return s
+}
+
+// lenCheck returns the check on a length corresponding to the operator
+func lenCheck(op string, n int) ValCk[int] {
+	switch op {
+	case ">=":
+		return ValGE(n)
+	case "<=":
+		return ValLE(n)
+	case "!=":
+		return ValNE(n)
+	case ">":
+		return ValGT(n)
+	case "<":
+		return ValLT(n)
+	}
+
+	return ValEQ(n)
+}
+
+// lenRule returns a check on the length of a string, slice or map
+func lenRule(t reflect.Type, op, val string,
+) (func(rv reflect.Value) error, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return nil, fmt.Errorf("bad length: %q", val)
+	}
+
+	lenCk := lenCheck(op, n)
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.String:
+		ck := StringLength[string](lenCk)
+		return func(rv reflect.Value) error { return ck(rv.String()) }, nil
+	case reflect.Slice, reflect.Array:
+		return func(rv reflect.Value) error {
+			return SliceLength[[]any](lenCk)(anySlice(rv))
+		}, nil
+	case reflect.Map:
+		return func(rv reflect.Value) error {
+			if err := lenCk(rv.Len()); err != nil {
+				return newCheckError("MapLength", nil,
+					map[string]any{"length": rv.Len()},
+					"the length of the map (%d) is incorrect: %w",
+					rv.Len(), err).sevOf(err)
+			}
+
+			return nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf("the field must be a string, slice or map, not %s",
+		t)
+}
+
+// stringRule returns a check on a string value
+func stringRule(t reflect.Type, name, val string,
+) (func(rv reflect.Value) error, error) {
+	if t.Kind() != reflect.String {
+		return nil, fmt.Errorf("the field must be a string, not %s", t)
+	}
+
+	var ck ValCk[string]
+
+	switch name {
+	case "prefix":
+		ck = StringHasPrefix[string](val)
+	case "suffix":
+		ck = StringHasSuffix[string](val)
+	case "contains":
+		ck = StringContains[string](val)
+	default:
+		re, err := regexp.Compile(val)
+		if err != nil {
+			return nil, fmt.Errorf("bad regexp: %w", err)
+		}
+
+		ck = StringMatchesPattern[string](re,
+			fmt.Sprintf("a string matching %q", val))
+	}
+
+	return func(rv reflect.Value) error { return ck(rv.String()) }, nil
+}
+
+// cmpRule returns a check comparing the value with the parsed val
+func cmpRule(t reflect.Type, name, val string,
+) (func(rv reflect.Value) error, error) {
+	switch {
+	case t == typeTime:
+		limit, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"bad time (it must be in RFC 3339 format): %w", err)
+		}
+
+		ck := timeCmpCheck(name, limit)
+
+		return func(rv reflect.Value) error {
+			return ck(rv.Interface().(time.Time)) //nolint:forcetypeassert
+		}, nil
+	case t == typeDuration:
+		limit, err := time.ParseDuration(val)
+		if err != nil {
+			return nil, fmt.Errorf("bad duration: %w", err)
+		}
+
+		ck := cmpCheck(name, limit)
+
+		return func(rv reflect.Value) error {
+			return ck(time.Duration(rv.Int()))
+		}, nil
+	case t.Kind() == reflect.String:
+		ck := cmpCheck(name, val)
+		return func(rv reflect.Value) error { return ck(rv.String()) }, nil
+	case rvCanInt(t):
+		limit, err := strconv.ParseInt(val, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad integer: %q", val)
+		}
+
+		ck := cmpCheck(name, limit)
+
+		return func(rv reflect.Value) error { return ck(rv.Int()) }, nil
+	case rvCanUint(t):
+		limit, err := strconv.ParseUint(val, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad unsigned integer: %q", val)
+		}
+
+		ck := cmpCheck(name, limit)
+
+		return func(rv reflect.Value) error { return ck(rv.Uint()) }, nil
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		limit, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad number: %q", val)
+		}
+
+		ck := cmpCheck(name, limit)
+
+		return func(rv reflect.Value) error { return ck(rv.Float()) }, nil
+	}
+
+	return nil, fmt.Errorf("values of type %s cannot be compared", t)
+}
+
+// rvCanInt returns true if the type is a signed integer type
+func rvCanInt(t reflect.Type) bool {
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return true
+	}
+
+	return false
+}
+
+// rvCanUint returns true if the type is an unsigned integer type
+func rvCanUint(t reflect.Type) bool {
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+
+	return false
+}
+
+// cmpCheck returns the comparison check corresponding to the name
+func cmpCheck[T interface {
+	~int64 | ~uint64 | ~float64 | ~string
+}](name string, limit T,
+) ValCk[T] {
+	switch name {
+	case "ne":
+		return ValNE(limit)
+	case "gt":
+		return ValGT(limit)
+	case "ge":
+		return ValGE(limit)
+	case "lt":
+		return ValLT(limit)
+	case "le":
+		return ValLE(limit)
+	}
+
+	return ValEQ(limit)
+}
+
+// timeCmpCheck returns the time comparison check corresponding to the name
+func timeCmpCheck(name string, limit time.Time) ValCk[time.Time] {
+	switch name {
+	case "ne":
+		return TimeNE(limit)
+	case "gt":
+		return TimeGT(limit)
+	case "ge":
+		return TimeGE(limit)
+	case "lt":
+		return TimeLT(limit)
+	case "le":
+		return TimeLE(limit)
+	}
+
+	return TimeEQ(limit)
+}
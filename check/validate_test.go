@@ -0,0 +1,311 @@
+package check_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+type vtServer struct {
+	Host    string        `check:"len>0"`
+	Port    int           `check:"ge=1,le=65535"`
+	Timeout time.Duration `check:"gt=0s,le=1m"`
+}
+
+type vtConfig struct {
+	Name     string              `check:"prefix=/,len>1"`
+	Ratio    float64             `check:"ge=0,lt=1"`
+	Count    *uint               `check:"le=10"`
+	Tags     []string            `check:"nodups,len<=3"`
+	Start    time.Time           `check:"gt=2000-01-01T00:00:00Z"`
+	Servers  []vtServer          `check:"len>=1"`
+	ByName   map[string]vtServer `check:"len!=99"`
+	Primary  *vtServer
+	Required string `check:"nonzero"`
+	hidden   int
+}
+
+type vtNode struct {
+	Val    int `check:"ge=1"`
+	Parent *vtNode
+	Kids   []*vtNode
+}
+
+type vtInner struct {
+	X int `check:"ge=1"`
+}
+
+type vtOuter struct {
+	vtInner
+}
+
+type vtOuterPtr struct {
+	*vtInner
+}
+
+type vtAnySlice struct {
+	Vals []any `check:"nodups"`
+}
+
+func TestValidate(t *testing.T) {
+	three := uint(3)
+	eleven := uint(11)
+	goodStart := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	goodServer := vtServer{Host: "a", Port: 80, Timeout: time.Second}
+
+	root := &vtNode{Val: 1}
+	kid := &vtNode{Parent: root}
+	root.Kids = []*vtNode{kid, kid}
+
+	cyclicMap := map[string]any{"a": vtServer{}}
+	cyclicMap["self"] = cyclicMap
+
+	cyclicSlice := []any{nil, vtServer{}}
+	cyclicSlice[0] = cyclicSlice
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val      any
+		expPaths []string
+	}{
+		{
+			ID: testhelper.MkID("good"),
+			val: vtConfig{
+				Name:     "/x",
+				Ratio:    0.5,
+				Count:    &three,
+				Tags:     []string{"a", "b"},
+				Start:    goodStart,
+				Servers:  []vtServer{goodServer},
+				Required: "yes",
+			},
+		},
+		{
+			ID: testhelper.MkID("good - pointer"),
+			val: &vtConfig{
+				Name:     "/x",
+				Start:    goodStart,
+				Servers:  []vtServer{goodServer},
+				Required: "yes",
+				hidden:   -1,
+			},
+		},
+		{
+			ID: testhelper.MkID("bad - many fields"),
+			ExpErr: testhelper.MkExpErr(
+				`Name: "x" should have "/" as a prefix`,
+				"Ratio: the value (1) must be less than 1",
+				"Count: the value (11) must be less than or equal to 10",
+				"Tags: duplicate list entries: 0 and 1 are both: a",
+				"Start: the time (",
+				"Servers[1].Port: the value (0) must be"+
+					" greater than or equal to 1",
+				`ByName["b"].Timeout: the value (2m0s) must be`+
+					" less than or equal to 1m0s",
+				"Primary.Host: the length of the string (0) is incorrect",
+				"Required: the value must not be the zero value",
+			),
+			val: vtConfig{
+				Name:    "x",
+				Ratio:   1,
+				Count:   &eleven,
+				Tags:    []string{"a", "a"},
+				Servers: []vtServer{goodServer, {Host: "b", Timeout: 1}},
+				ByName: map[string]vtServer{
+					"a": goodServer,
+					"b": {Host: "b", Port: 1, Timeout: 2 * time.Minute},
+				},
+				Primary: &vtServer{Port: 1, Timeout: 1},
+			},
+			expPaths: []string{
+				".Name",
+				".Ratio",
+				".Count",
+				".Tags",
+				".Start",
+				".Servers[1].Port",
+				`.ByName["b"].Timeout`,
+				".Primary.Host",
+				".Required",
+			},
+		},
+		{
+			ID: testhelper.MkID("bad - slice of structs"),
+			ExpErr: testhelper.MkExpErr(
+				"[1].Host: the length of the string (0) is incorrect"),
+			val:      []vtServer{goodServer, {Port: 1, Timeout: 1}},
+			expPaths: []string{"[1].Host"},
+		},
+		{
+			ID: testhelper.MkID("bad - map with a NaN key"),
+			ExpErr: testhelper.MkExpErr(
+				"[NaN].Port: the value (0) must be"+
+					" greater than or equal to 1",
+				"[1].Port: the value (0) must be"+
+					" greater than or equal to 1"),
+			val: map[float64]vtServer{
+				math.NaN(): {Host: "a", Timeout: 1},
+				1:          {Host: "b", Timeout: 1},
+			},
+			expPaths: []string{"[NaN].Port", "[1].Port"},
+		},
+		{
+			ID:  testhelper.MkID("not a struct"),
+			val: 42,
+		},
+		{
+			ID: testhelper.MkID("bad - cyclic"),
+			ExpErr: testhelper.MkExpErr(
+				"Kids[0].Val: the value (0) must be" +
+					" greater than or equal to 1"),
+			val:      root,
+			expPaths: []string{".Kids[0].Val"},
+		},
+		{
+			ID: testhelper.MkID("bad - cyclic map"),
+			ExpErr: testhelper.MkExpErr(
+				`["a"].Host: the length of the string (0) is incorrect`),
+			val: cyclicMap,
+			expPaths: []string{
+				`["a"].Host`, `["a"].Port`, `["a"].Timeout`,
+			},
+		},
+		{
+			ID: testhelper.MkID("bad - cyclic slice"),
+			ExpErr: testhelper.MkExpErr(
+				"[1].Host: the length of the string (0) is incorrect"),
+			val:      cyclicSlice,
+			expPaths: []string{"[1].Host", "[1].Port", "[1].Timeout"},
+		},
+		{
+			ID: testhelper.MkID("bad - unexported embedded struct"),
+			ExpErr: testhelper.MkExpErr(
+				"vtInner.X: the value (0) must be" +
+					" greater than or equal to 1"),
+			val:      vtOuter{},
+			expPaths: []string{".vtInner.X"},
+		},
+		{
+			ID: testhelper.MkID("bad - unexported embedded pointer"),
+			ExpErr: testhelper.MkExpErr(
+				"vtInner.X: the value (-1) must be" +
+					" greater than or equal to 1"),
+			val:      vtOuterPtr{&vtInner{X: -1}},
+			expPaths: []string{".vtInner.X"},
+		},
+		{
+			ID:  testhelper.MkID("good - unexported embedded nil pointer"),
+			val: vtOuterPtr{},
+		},
+		{
+			ID:  testhelper.MkID("good - nodups on interfaces"),
+			val: vtAnySlice{Vals: []any{1, "1", 1.0}},
+		},
+		{
+			ID: testhelper.MkID("bad - nodups on interfaces, duplicate"),
+			ExpErr: testhelper.MkExpErr(
+				"Vals: duplicate list entries: 0 and 2 are both: 1"),
+			val:      vtAnySlice{Vals: []any{1, "1", 1}},
+			expPaths: []string{".Vals"},
+		},
+		{
+			ID: testhelper.MkID("bad - nodups on interfaces, uncomparable"),
+			ExpErr: testhelper.MkExpErr(
+				"Vals: entry 1 ([]int) is not comparable"),
+			val:      vtAnySlice{Vals: []any{1, []int{1}, []int{1}}},
+			expPaths: []string{".Vals"},
+		},
+	}
+
+	for _, tc := range testCases {
+		err := check.Validate(tc.val)
+		if !testhelper.CheckExpErr(t, err, tc) || err == nil {
+			continue
+		}
+
+		var ce *check.CheckError
+		if !errors.As(err, &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error is not a *check.CheckError: %T", err)
+
+			continue
+		}
+
+		paths := []string{}
+		for _, e := range ce.Errs {
+			paths = append(paths, check.ErrPath(e).String())
+		}
+
+		testhelper.DiffStringSlice(t, tc.IDStr(), "paths", paths, tc.expPaths)
+	}
+}
+
+func TestValidateBadTag(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val any
+	}{
+		{
+			ID: testhelper.MkID("unknown rule"),
+			ExpErr: testhelper.MkExpErr(
+				`bad "check" tag on .F: rule "xx=1": unknown rule`),
+			val: struct {
+				F int `check:"xx=1"`
+			}{},
+		},
+		{
+			ID: testhelper.MkID("bad integer"),
+			ExpErr: testhelper.MkExpErr(
+				`rule "ge=one": bad integer: "one"`),
+			val: struct {
+				F int `check:"ge=one"`
+			}{},
+		},
+		{
+			ID:     testhelper.MkID("prefix on an int"),
+			ExpErr: testhelper.MkExpErr("the field must be a string, not int"),
+			val: struct {
+				F int `check:"prefix=x"`
+			}{},
+		},
+		{
+			ID: testhelper.MkID("nodups on uncomparable entries"),
+			ExpErr: testhelper.MkExpErr(
+				"the entries ([]int) are not comparable"),
+			val: struct {
+				F [][]int `check:"nodups"`
+			}{},
+		},
+		{
+			ID:     testhelper.MkID("unexported field"),
+			ExpErr: testhelper.MkExpErr("the field is not exported"),
+			val: struct {
+				f int `check:"ge=1"`
+			}{},
+		},
+		{
+			ID:     testhelper.MkID("bad regexp"),
+			ExpErr: testhelper.MkExpErr("bad regexp"),
+			val: struct {
+				F string `check:"match=["`
+			}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		err := check.Validate(tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+
+		var ce *check.CheckError
+		if errors.As(err, &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: a bad tag should not give a *check.CheckError")
+		}
+	}
+}
@@ -1,22 +1,13 @@
 package check
 
-import "fmt"
+import "github.com/nickwells/check.mod/v2/check/internal/keyorder"
 
 // MapLength returns a function that will apply the supplied check func to
 // the length of a supplied value and return an error if the check function
 // returns an error
 func MapLength[M ~map[K]V, K comparable, V any](cf ValCk[int]) ValCk[M] {
-	return func(v M) error {
-		lv := len(v)
-
-		err := cf(lv)
-		if err == nil {
-			return nil
-		}
-
-		return fmt.Errorf("the length of the map (%d) is incorrect: %w",
-			lv, err)
-	}
+	return project("MapLength", "length",
+		func(v M) int { return len(v) }, "length of the map", cf)
 }
 
 // MapKeyAggregate returns a function that will apply the Aggregate method of
@@ -59,38 +50,130 @@ func MapValAggregate[M ~map[K]V, K comparable, V any](a Aggregator[V]) ValCk[M]
 
 // MapKeyAll returns a function that will apply the supplied check function
 // to each key in the map and will return an error for the first key for
-// which it fails.
+// which it fails. A failure marked as a warning does not stop the checks,
+// see Warning for details.
 //
 // It returns nil if all the keys pass the supplied check
 func MapKeyAll[M ~map[K]V, K comparable, V any](cf ValCk[K]) ValCk[M] {
 	return func(m M) error {
+		var ws warnings
+
 		for k := range m {
-			if err := cf(k); err != nil {
-				return fmt.Errorf("map entry[%v], bad key: %w", k, err)
+			err := cf(k)
+			if err == nil {
+				continue
 			}
+
+			keyErr := mapKeyErr("MapKeyAll", m, k, err)
+			if keyErr.Severity != SevWarning {
+				return ws.result("MapKeyAll", m, keyErr)
+			}
+
+			ws = append(ws, keyErr)
 		}
 
-		return nil
+		return ws.result("MapKeyAll", m, nil)
 	}
 }
 
 // MapValAll returns a function that will apply the supplied check function
 // to each value in the map and will return an error for the first value for
-// which it fails.
+// which it fails. A failure marked as a warning does not stop the checks,
+// see Warning for details.
 //
 // It returns nil if all the values pass the supplied check
 func MapValAll[M ~map[K]V, K comparable, V any](cf ValCk[V]) ValCk[M] {
 	return func(m M) error {
+		var ws warnings
+
 		for k, v := range m {
-			if err := cf(v); err != nil {
-				return fmt.Errorf("map entry[%v], bad value: %w", k, err)
+			err := cf(v)
+			if err == nil {
+				continue
+			}
+
+			valErr := mapValErr("MapValAll", m, k, v, err)
+			if valErr.Severity != SevWarning {
+				return ws.result("MapValAll", m, valErr)
 			}
+
+			ws = append(ws, valErr)
 		}
 
-		return nil
+		return ws.result("MapValAll", m, nil)
 	}
 }
 
+// MapKeyAllErrs returns a function that will apply the supplied check
+// function to each key in the map. Unlike MapKeyAll it does not stop at the
+// first failing key, every key is checked and if any of them fail the
+// returned error will wrap an error for each failing key. These errors are
+// given in key order so that the results are reproducible: numbers come
+// first, in numerical order, then strings and then any other keys, ordered
+// by their type and printed form. Each of them records the failing key in
+// its "key" parameter.
+//
+// It returns nil if all the keys pass the supplied check
+func MapKeyAllErrs[M ~map[K]V, K comparable, V any](cf ValCk[K]) ValCk[M] {
+	return func(m M) error {
+		var errs []*CheckError
+
+		for _, k := range keyorder.Keys(m) {
+			if err := cf(k); err != nil {
+				errs = append(errs, mapKeyErr("MapKeyAllErrs", m, k, err))
+			}
+		}
+
+		return allErrs("MapKeyAllErrs", m, errs)
+	}
+}
+
+// MapValAllErrs returns a function that will apply the supplied check
+// function to each value in the map. Unlike MapValAll it does not stop at
+// the first failing value, every value is checked and if any of them fail
+// the returned error will wrap an error for each failing value. These
+// errors are given in key order (as for MapKeyAllErrs) so that the results
+// are reproducible. Each of them records the key of the failing value in
+// its "key" parameter.
+//
+// It returns nil if all the values pass the supplied check
+func MapValAllErrs[M ~map[K]V, K comparable, V any](cf ValCk[V]) ValCk[M] {
+	return func(m M) error {
+		var errs []*CheckError
+
+		for _, e := range keyorder.Entries(m) {
+			if err := cf(e.Val); err != nil {
+				errs = append(errs,
+					mapValErr("MapValAllErrs", m, e.Key, e.Val, err))
+			}
+		}
+
+		return allErrs("MapValAllErrs", m, errs)
+	}
+}
+
+// mapKeyErr returns the error for a map key which has failed a check. It
+// has the same severity as the error from the check.
+func mapKeyErr[M ~map[K]V, K comparable, V any](checkID string, m M, k K,
+	err error,
+) *CheckError {
+	return newCheckError(checkID, m, map[string]any{"key": k},
+		"map entry[%v], bad key: %w", k, err).
+		at(KeyElem(k)).sevOf(err)
+}
+
+// mapValErr returns the error for the map value, v, with key k which has
+// failed a check. It has the same severity as the error from the check. The
+// value is passed rather than looked up as a key which is not equal to
+// itself (such as a NaN) cannot be used to find its value.
+func mapValErr[M ~map[K]V, K comparable, V any](checkID string, m M, k K,
+	v V, err error,
+) *CheckError {
+	return newCheckError(checkID, m, map[string]any{"key": k, "entry": v},
+		"map entry[%v], bad value: %w", k, err).
+		at(KeyElem(k)).sevOf(err)
+}
+
 // MapKeyAny returns a function that will apply the supplied check function
 // to each key in the map and will return an error if all of them fail the
 // test. The msg parameter should describe the check being performed. For
@@ -109,7 +192,8 @@ func MapKeyAny[M ~map[K]V, K comparable, V any](cf ValCk[K], msg string) ValCk[M
 			}
 		}
 
-		return fmt.Errorf("no map keys pass the test: %s", msg)
+		return newCheckError("MapKeyAny", m, map[string]any{"msg": msg},
+			"no map keys pass the test: %s", msg)
 	}
 }
 
@@ -131,6 +215,7 @@ func MapValAny[M ~map[K]V, K comparable, V any](cf ValCk[V], msg string) ValCk[M
 			}
 		}
 
-		return fmt.Errorf("no map values pass the test: %s", msg)
+		return newCheckError("MapValAny", m, map[string]any{"msg": msg},
+			"no map values pass the test: %s", msg)
 	}
 }
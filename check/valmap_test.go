@@ -1,6 +1,8 @@
 package check_test
 
 import (
+	"errors"
+	"math"
 	"testing"
 
 	"github.com/nickwells/check.mod/v2/check"
@@ -169,3 +171,123 @@ func TestMap(t *testing.T) {
 		testhelper.CheckExpErr(t, err, tc)
 	}
 }
+
+func TestMapAllErrs(t *testing.T) {
+	m := map[string]int{
+		"d": 4,
+		"a": 10,
+		"c": 30,
+		"b": 2,
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		checkFunc check.ValCk[map[string]int]
+		expErrMsg string
+	}{
+		{
+			ID: testhelper.MkID("MapKeyAllErrs - ok"),
+			checkFunc: check.MapKeyAllErrs[map[string]int](
+				check.StringLength[string](check.ValEQ(1))),
+		},
+		{
+			ID: testhelper.MkID("MapKeyAllErrs - fail"),
+			checkFunc: check.MapKeyAllErrs[map[string]int](
+				check.ValGT("b")),
+			expErrMsg: "map entry[a], bad key:" +
+				" the value (a) must be greater than b\n" +
+				"map entry[b], bad key:" +
+				" the value (b) must be greater than b",
+		},
+		{
+			ID: testhelper.MkID("MapValAllErrs - ok"),
+			checkFunc: check.MapValAllErrs[map[string]int](
+				check.ValLT(100)),
+		},
+		{
+			ID: testhelper.MkID("MapValAllErrs - fail"),
+			checkFunc: check.MapValAllErrs[map[string]int](
+				check.ValLT(5)),
+			expErrMsg: "map entry[a], bad value:" +
+				" the value (10) must be less than 5\n" +
+				"map entry[c], bad value:" +
+				" the value (30) must be less than 5",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(m)
+		if tc.expErrMsg == "" {
+			testhelper.DiffErr(t, tc.IDStr(), "error", err, nil)
+			continue
+		}
+
+		if err == nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: an error was expected but none was returned")
+
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "error", err.Error(), tc.expErrMsg)
+	}
+}
+
+func TestMapValNaNKey(t *testing.T) {
+	m := map[float64]int{math.NaN(): 5, 1: 2}
+	cf := check.ValLT(3)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checkFunc check.ValCk[map[float64]int]
+	}{
+		{
+			ID:        testhelper.MkID("MapValAll"),
+			ExpErr:    testhelper.MkExpErr("the value (5) must be less than 3"),
+			checkFunc: check.MapValAll[map[float64]int](cf),
+		},
+		{
+			ID:        testhelper.MkID("MapValAllErrs"),
+			ExpErr:    testhelper.MkExpErr("the value (5) must be less than 3"),
+			checkFunc: check.MapValAllErrs[map[float64]int](cf),
+		},
+		{
+			ID:        testhelper.MkID("MapValAllParallel"),
+			ExpErr:    testhelper.MkExpErr("the value (5) must be less than 3"),
+			checkFunc: check.MapValAllParallel[map[float64]int](2, cf),
+		},
+		{
+			ID:        testhelper.MkID("MapValAllErrsParallel"),
+			ExpErr:    testhelper.MkExpErr("the value (5) must be less than 3"),
+			checkFunc: check.MapValAllErrsParallel[map[float64]int](2, cf),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.checkFunc(m)
+		if !testhelper.CheckExpErr(t, err, tc) || err == nil {
+			continue
+		}
+
+		var ce *check.CheckError
+		if !errors.As(err, &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error is not a *check.CheckError: %T", err)
+
+			continue
+		}
+
+		// the ...Errs variants wrap the error for each failing entry
+		entryErr := ce
+		if _, ok := ce.Params["entry"]; !ok && len(ce.Errs) > 0 {
+			var wrapped *check.CheckError
+			if errors.As(ce.Errs[0], &wrapped) {
+				entryErr = wrapped
+			}
+		}
+
+		entry, _ := entryErr.Params["entry"].(int)
+		testhelper.DiffInt(t, tc.IDStr(), "entry param", entry, 5)
+	}
+}
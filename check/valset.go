@@ -0,0 +1,152 @@
+package check
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSuggestDist is the greatest edit distance at which an allowed value
+// will be suggested as an alternative to a bad value
+const maxSuggestDist = 2
+
+// ValOneOf returns a function that will check that the value is one of the
+// given values. If the values are strings (of any type whose underlying
+// type is string) and the value is close to one of them, the error will
+// suggest it; this is useful for checking parameters which must take one
+// of a fixed set of values. The suggestion is also recorded in the
+// "suggestion" parameter of the error. It will panic if no values are
+// given.
+func ValOneOf[T comparable](vals ...T) ValCk[T] {
+	if len(vals) == 0 {
+		panic("Impossible checks passed to ValOneOf: no values have been given")
+	}
+
+	vals = slices.Clone(vals)
+
+	return func(v T) error {
+		if slices.Contains(vals, v) {
+			return nil
+		}
+
+		allowed := list{conj: "or"}
+		for _, val := range vals {
+			allowed.items = append(allowed.items, val)
+		}
+
+		params := map[string]any{"values": vals}
+
+		if s, ok := suggest(v, vals); ok {
+			params["suggestion"] = s
+
+			return newCheckError("ValOneOf", v, params,
+				"the value (%v) must be one of %v - did you mean %v?",
+				v, allowed, s)
+		}
+
+		return newCheckError("ValOneOf", v, params,
+			"the value (%v) must be one of %v", v, allowed)
+	}
+}
+
+// ValNoneOf returns a function that will check that the value is not any
+// of the given values.
+func ValNoneOf[T comparable](vals ...T) ValCk[T] {
+	vals = slices.Clone(vals)
+
+	return func(v T) error {
+		if !slices.Contains(vals, v) {
+			return nil
+		}
+
+		disallowed := list{conj: "or"}
+		for _, val := range vals {
+			disallowed.items = append(disallowed.items, val)
+		}
+
+		return newCheckError("ValNoneOf", v, map[string]any{"values": vals},
+			"the value (%v) must not be %v", v, disallowed)
+	}
+}
+
+// ValInSet returns a function that will check that the value is in the
+// set. For instance, to check that a value is between 1 and 5 or between
+// 10 and 20 (inclusive) you could use
+//
+//	check.ValInSet(check.NewIntervalSet(
+//		check.IntervalBetween(1, 5),
+//		check.IntervalBetween(10, 20)))
+//
+// It will panic if the set is empty.
+func ValInSet[T any](s IntervalSet[T]) ValCk[T] {
+	if s.IsEmpty() {
+		panic("Impossible checks passed to ValInSet: the set is empty")
+	}
+
+	return func(v T) error {
+		if s.Contains(v) {
+			return nil
+		}
+
+		return newCheckError("ValInSet", v, map[string]any{"set": s},
+			"the value (%v) must be in %v", v, s)
+	}
+}
+
+// suggest returns the value closest to v (as measured by the edit distance
+// ignoring case) and true if the values are strings and the closest is near
+// enough to be worth suggesting. Otherwise it returns false.
+func suggest[T comparable](v T, vals []T) (T, bool) {
+	var best T
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.String {
+		return best, false
+	}
+
+	target := strings.ToLower(rv.String())
+	bestDist := maxSuggestDist + 1
+
+	for _, val := range vals {
+		s := strings.ToLower(reflect.ValueOf(val).String())
+
+		d := editDistance(target, s)
+		if d < bestDist && d < utf8.RuneCountInString(s) {
+			best, bestDist = val, d
+		}
+	}
+
+	return best, bestDist <= maxSuggestDist
+}
+
+// editDistance returns the Levenshtein distance between the two strings:
+// the number of single character insertions, deletions or substitutions
+// needed to change one into the other.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range ra {
+		curr[0] = i + 1
+
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,160 @@
+package check_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+type testLevel string
+
+func TestValOneOf(t *testing.T) {
+	levels := check.ValOneOf[testLevel]("quiet", "normal", "verbose")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val           testLevel
+		expSuggestion any
+	}{
+		{
+			ID:  testhelper.MkID("good"),
+			val: "normal",
+		},
+		{
+			ID: testhelper.MkID("bad - near"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (verbos) must be one of quiet, normal or verbose" +
+					" - did you mean verbose?"),
+			val:           "verbos",
+			expSuggestion: testLevel("verbose"),
+		},
+		{
+			ID: testhelper.MkID("bad - wrong case"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (Quiet) must be one of quiet, normal or verbose" +
+					" - did you mean quiet?"),
+			val:           "Quiet",
+			expSuggestion: testLevel("quiet"),
+		},
+		{
+			ID: testhelper.MkID("bad - far"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (loud) must be one of quiet, normal or verbose"),
+			val: "loud",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := levels(tc.val)
+		if !testhelper.CheckExpErr(t, err, tc) || err == nil {
+			continue
+		}
+
+		var ce *check.CheckError
+		if !errors.As(err, &ce) {
+			t.Fatal("ValOneOf should return a *check.CheckError")
+		}
+
+		if s, _ := ce.Param("suggestion"); s != tc.expSuggestion {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: expected suggestion: %v", tc.expSuggestion)
+			t.Errorf("\t:   actual suggestion: %v", s)
+		}
+	}
+}
+
+func TestValOneOfInt(t *testing.T) {
+	ck := check.ValOneOf(1, 2, 3)
+
+	testhelper.DiffErr(t, "ValOneOf - int", "good", ck(2), nil)
+
+	testhelper.DiffString(t, "ValOneOf - int", "bad", ck(4).Error(),
+		"the value (4) must be one of 1, 2 or 3")
+}
+
+func TestValNoneOf(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val string
+	}{
+		{
+			ID:  testhelper.MkID("good"),
+			val: "x",
+		},
+		{
+			ID: testhelper.MkID("bad"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (b) must not be a or b"),
+			val: "b",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, check.ValNoneOf("a", "b")(tc.val), tc)
+	}
+}
+
+func TestValInSet(t *testing.T) {
+	ck := check.ValInSet(check.NewIntervalSet(
+		check.IntervalBetween(1, 5),
+		check.IntervalBetween(10, 20)))
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		val int
+	}{
+		{
+			ID:  testhelper.MkID("good - first"),
+			val: 5,
+		},
+		{
+			ID:  testhelper.MkID("good - second"),
+			val: 10,
+		},
+		{
+			ID: testhelper.MkID("bad"),
+			ExpErr: testhelper.MkExpErr(
+				"the value (7) must be in {[1, 5], [10, 20]}"),
+			val: 7,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.CheckExpErr(t, ck(tc.val), tc)
+	}
+}
+
+func TestValSetPanic(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		f func()
+	}{
+		{
+			ID: testhelper.MkID("ValOneOf - no values"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValOneOf"),
+			f: func() { check.ValOneOf[int]() },
+		},
+		{
+			ID: testhelper.MkID("ValInSet - empty set"),
+			ExpPanic: testhelper.MkExpPanic(
+				"Impossible checks passed to ValInSet"),
+			f: func() { check.ValInSet(check.NewIntervalSet[int]()) },
+		},
+		{
+			ID: testhelper.MkID("ValNoneOf - no values"),
+			f:  func() { check.ValNoneOf[int]() },
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(tc.f)
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+}
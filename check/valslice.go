@@ -1,24 +1,11 @@
 package check
 
-import (
-	"fmt"
-)
-
 // SliceLength returns a function that will apply the supplied check func to
 // the length of a supplied value and return an error if the check function
 // returns an error
 func SliceLength[S ~[]E, E any](cf ValCk[int]) ValCk[S] {
-	return func(v S) error {
-		lv := len(v)
-
-		err := cf(lv)
-		if err == nil {
-			return nil
-		}
-
-		return fmt.Errorf("the length of the list (%d) is incorrect: %w",
-			lv, err)
-	}
+	return project("SliceLength", "length",
+		func(v S) int { return len(v) }, "length of the list", cf)
 }
 
 // SliceAggregate returns a function that will apply the Aggregate method of
@@ -43,27 +30,64 @@ func SliceAggregate[S ~[]E, E any](a Aggregator[E]) ValCk[S] {
 // SliceAll returns a function that will apply the supplied check func
 // to each of the elements of the slice in turn and if any one of them fails
 // the test it's location (index) in the slice and the error will be returned
-// as an error.
+// as an error. A failure marked as a warning does not stop the checks, see
+// Warning for details.
 //
 // It returns nil if all the entries pass the check.
 func SliceAll[S ~[]E, E any](cf ValCk[E]) ValCk[S] {
 	return func(v S) error {
-		if len(v) == 0 {
-			return nil
+		var ws warnings
+
+		for i, e := range v {
+			err := cf(e)
+			if err == nil {
+				continue
+			}
+
+			entryErr := sliceEntryErr("SliceAll", v, i, e, err)
+			if entryErr.Severity != SevWarning {
+				return ws.result("SliceAll", v, entryErr)
+			}
+
+			ws = append(ws, entryErr)
 		}
 
+		return ws.result("SliceAll", v, nil)
+	}
+}
+
+// SliceAllErrs returns a function that will apply the supplied check func
+// to each of the elements of the slice in turn. Unlike SliceAll it does not
+// stop at the first failing entry, every entry is checked and if any of
+// them fail the returned error will wrap an error for each failing entry,
+// in index order. Each of these errors records the index of the failing
+// entry in its "index" parameter.
+//
+// It returns nil if all the entries pass the check.
+func SliceAllErrs[S ~[]E, E any](cf ValCk[E]) ValCk[S] {
+	return func(v S) error {
+		var errs []*CheckError
+
 		for i, e := range v {
 			if err := cf(e); err != nil {
-				return fmt.Errorf(
-					"list entry: %d (%v) does not pass the test: %w",
-					i, e, err)
+				errs = append(errs, sliceEntryErr("SliceAllErrs", v, i, e, err))
 			}
 		}
 
-		return nil
+		return allErrs("SliceAllErrs", v, errs)
 	}
 }
 
+// sliceEntryErr returns the error for a slice entry which has failed a
+// check. It has the same severity as the error from the check.
+func sliceEntryErr[S ~[]E, E any](checkID string, v S, i int, e E, err error,
+) *CheckError {
+	return newCheckError(checkID, v,
+		map[string]any{"index": i, "entry": e},
+		"list entry: %d (%v) does not pass the test: %w", i, e, err).
+		at(IndexElem(i)).sevOf(err)
+}
+
 // SliceAny returns a function that will apply the supplied check
 // func to each of the elements of the slice in turn and if all of them fail
 // the test it returns an error. The msg parameter should describe the check
@@ -82,7 +106,8 @@ func SliceAny[S ~[]E, E any](cf ValCk[E], msg string) ValCk[S] {
 			}
 		}
 
-		return fmt.Errorf("no list entries pass the test: %s", msg)
+		return newCheckError("SliceAny", v, map[string]any{"msg": msg},
+			"no list entries pass the test: %s", msg)
 	}
 }
 
@@ -100,14 +125,10 @@ func SliceByPos[S ~[]E, E any](cfs ...ValCk[E]) ValCk[S] {
 		}
 
 		for i, e := range v {
-			if i >= len(cfs) {
-				i = len(cfs) - 1
-			}
+			cfIdx := min(i, len(cfs)-1)
 
-			if err := cfs[i](e); err != nil {
-				return fmt.Errorf(
-					"list entry: %d (%v) does not pass the test: %w",
-					i, e, err)
+			if err := cfs[cfIdx](e); err != nil {
+				return sliceEntryErr("SliceByPos", v, i, e, err)
 			}
 		}
 
@@ -120,7 +141,9 @@ func SliceHasNoDups[S ~[]E, E comparable](v S) error {
 	dupMap := make(map[E]int)
 	for i, s := range v {
 		if dup, ok := dupMap[s]; ok {
-			return fmt.Errorf("duplicate list entries: %d and %d are both: %v",
+			return newCheckError("SliceHasNoDups", v,
+				map[string]any{"index": i, "dupIndex": dup, "entry": s},
+				"duplicate list entries: %d and %d are both: %v",
 				dup, i, s)
 		}
 
@@ -1,6 +1,7 @@
 package check_test
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 
@@ -310,3 +311,68 @@ func TestSliceLengthValBetweenPanic(t *testing.T) {
 		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
 	}
 }
+
+func TestSliceAllErrs(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		val        []int
+		expErrMsg  string
+		expIndexes []int
+	}{
+		{
+			ID:  testhelper.MkID("all good"),
+			val: []int{1, 2, 3},
+		},
+		{
+			ID:  testhelper.MkID("empty"),
+			val: []int{},
+		},
+		{
+			ID:  testhelper.MkID("two bad"),
+			val: []int{1, 7, 2, 9},
+			expErrMsg: "list entry: 1 (7) does not pass the test:" +
+				" the value (7) must be less than 5\n" +
+				"list entry: 3 (9) does not pass the test:" +
+				" the value (9) must be less than 5",
+			expIndexes: []int{1, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		err := check.SliceAllErrs[[]int](check.ValLT(5))(tc.val)
+		if tc.expErrMsg == "" {
+			testhelper.DiffErr(t, tc.IDStr(), "error", err, nil)
+			continue
+		}
+
+		if err == nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: an error was expected but none was returned")
+
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "error", err.Error(), tc.expErrMsg)
+
+		var ce *check.CheckError
+		if !errors.As(err, &ce) {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the error is not a *check.CheckError: %T", err)
+
+			continue
+		}
+
+		indexes := []int{}
+
+		for _, e := range ce.Errs {
+			var entryErr *check.CheckError
+			if errors.As(e, &entryErr) {
+				idx, _ := entryErr.Param("index")
+				indexes = append(indexes, idx.(int))
+			}
+		}
+
+		testhelper.DiffSlice(t, tc.IDStr(), "failing indexes",
+			indexes, tc.expIndexes)
+	}
+}
@@ -1,7 +1,6 @@
 package check
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 )
@@ -10,17 +9,8 @@ import (
 // the length of a supplied value and return an error if the check function
 // returns an error
 func StringLength[T ~string](cf ValCk[int]) ValCk[T] {
-	return func(v T) error {
-		lv := len(v)
-
-		err := cf(lv)
-		if err == nil {
-			return nil
-		}
-
-		return fmt.Errorf("the length of the string (%d) is incorrect: %w",
-			lv, err)
-	}
+	return project("StringLength", "length",
+		func(v T) int { return len(v) }, "length of the string", cf)
 }
 
 // StringMatchesPattern returns a function that checks that the string
@@ -32,7 +22,9 @@ func StringLength[T ~string](cf ValCk[int]) ValCk[T] {
 func StringMatchesPattern[T ~string](re *regexp.Regexp, reDesc string) ValCk[T] {
 	return func(v T) error {
 		if !re.MatchString(string(v)) {
-			return fmt.Errorf("%q should be: %s", v, reDesc)
+			return newCheckError("StringMatchesPattern", v,
+				map[string]any{"pattern": re.String(), "reDesc": reDesc},
+				"%q should be: %s", v, reDesc)
 		}
 
 		return nil
@@ -44,7 +36,9 @@ func StringMatchesPattern[T ~string](re *regexp.Regexp, reDesc string) ValCk[T]
 func StringHasPrefix[T ~string](prefix string) ValCk[T] {
 	return func(v T) error {
 		if !strings.HasPrefix(string(v), prefix) {
-			return fmt.Errorf("%q should have %q as a prefix", v, prefix)
+			return newCheckError("StringHasPrefix", v,
+				map[string]any{"prefix": prefix},
+				"%q should have %q as a prefix", v, prefix)
 		}
 
 		return nil
@@ -56,7 +50,9 @@ func StringHasPrefix[T ~string](prefix string) ValCk[T] {
 func StringHasSuffix[T ~string](suffix string) ValCk[T] {
 	return func(v T) error {
 		if !strings.HasSuffix(string(v), suffix) {
-			return fmt.Errorf("%q should have %q as a suffix", v, suffix)
+			return newCheckError("StringHasSuffix", v,
+				map[string]any{"suffix": suffix},
+				"%q should have %q as a suffix", v, suffix)
 		}
 
 		return nil
@@ -68,7 +64,9 @@ func StringHasSuffix[T ~string](suffix string) ValCk[T] {
 func StringContains[T ~string](substr string) ValCk[T] {
 	return func(v T) error {
 		if !strings.Contains(string(v), substr) {
-			return fmt.Errorf("%q should contain %q", v, substr)
+			return newCheckError("StringContains", v,
+				map[string]any{"substr": substr},
+				"%q should contain %q", v, substr)
 		}
 
 		return nil
@@ -80,7 +78,8 @@ func StringContains[T ~string](substr string) ValCk[T] {
 func StringFoldedEQ[T ~string](s string) ValCk[T] {
 	return func(v T) error {
 		if !strings.EqualFold(string(v), s) {
-			return fmt.Errorf("%q should equal %q when ignoring case", v, s)
+			return newCheckError("StringFoldedEQ", v, map[string]any{"s": s},
+				"%q should equal %q when ignoring case", v, s)
 		}
 
 		return nil